@@ -0,0 +1,258 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+)
+
+// errUsage is returned when the command line is invalid and the usage has already been printed.
+var errUsage = errors.New("usage")
+
+// command : A command of the command line, such as "users get".
+type command struct {
+	// The words which select the command, such as ["users", "get"].
+	path []string
+
+	// The positional arguments of the command, such as "USER_ID".
+	args []string
+
+	// A one line description of the command.
+	summary string
+
+	// Registers the flags of the command.
+	flags func(flags *flag.FlagSet)
+
+	// Runs the command with its positional arguments.
+	run func(ctx context.Context, c *cli, args []string) error
+}
+
+// name returns the words which select the command, such as "users get".
+func (cmd *command) name() string {
+	return strings.Join(cmd.path, " ")
+}
+
+// cli holds the global flags and the client shared by the commands.
+type cli struct {
+	stdout io.Writer
+	stderr io.Writer
+
+	serviceName string
+	crn         string
+	clusterID   string
+	output      string
+	timeout     time.Duration
+
+	commands []*command
+	hpdb     *hpdbv3.HpdbV3
+}
+
+func newCLI(stdout io.Writer, stderr io.Writer) *cli {
+	c := &cli{
+		stdout:      stdout,
+		stderr:      stderr,
+		serviceName: hpdbv3.DefaultServiceName,
+		output:      "table",
+	}
+	c.commands = commands()
+	return c
+}
+
+// globalFlags registers the flags which are accepted both before and after the command. Their defaults are the current
+// values, so that the flags given before the command are kept.
+func (c *cli) globalFlags(flags *flag.FlagSet) {
+	flags.StringVar(&c.serviceName, "service-name", c.serviceName, "the `name` used to find the external configuration, such as the HPDB_* environment variables")
+	flags.StringVar(&c.crn, "crn", c.crn, "the `CRN` of the database cluster")
+	flags.StringVar(&c.clusterID, "cluster-id", c.clusterID, "the `ID` of the database cluster, when the service URL already contains the account ID")
+	flags.StringVar(&c.output, "output", c.output, "the output `format`: table, json or yaml")
+	flags.DurationVar(&c.timeout, "timeout", c.timeout, "the maximum `duration` of the command, such as 10m; zero means no limit")
+}
+
+// run parses the command line and runs the selected command.
+func (c *cli) run(args []string) error {
+	global := flag.NewFlagSet("hpdb", flag.ContinueOnError)
+	global.SetOutput(c.stderr)
+	global.Usage = c.usage
+	c.globalFlags(global)
+	if err := global.Parse(args); err != nil {
+		return errUsage
+	}
+	args = global.Args()
+	if len(args) == 0 || args[0] == "help" {
+		c.usage()
+		if len(args) == 0 {
+			return errUsage
+		}
+		return nil
+	}
+
+	cmd := c.findCommand(args)
+	if cmd == nil {
+		fmt.Fprintf(c.stderr, "hpdb: unknown command %q\n", strings.Join(args, " "))
+		c.usage()
+		return errUsage
+	}
+
+	flags := flag.NewFlagSet("hpdb "+cmd.name(), flag.ContinueOnError)
+	flags.SetOutput(c.stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(c.stderr, "Usage: hpdb %s [flags]\n\n%s\n\nFlags:\n", strings.Join(append(cmd.path, cmd.args...), " "), cmd.summary)
+		flags.PrintDefaults()
+	}
+	c.globalFlags(flags)
+	if cmd.flags != nil {
+		cmd.flags(flags)
+	}
+	positional, err := parseInterspersed(flags, args[len(cmd.path):])
+	if err != nil {
+		return errUsage
+	}
+	if len(positional) != len(cmd.args) {
+		fmt.Fprintf(c.stderr, "hpdb: %s requires %d argument(s), got %d\n", cmd.name(), len(cmd.args), len(positional))
+		flags.Usage()
+		return errUsage
+	}
+	switch c.output {
+	case "table", "json", "yaml":
+	default:
+		return fmt.Errorf("invalid output format %q: use table, json or yaml", c.output)
+	}
+
+	ctx := context.Background()
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+	return cmd.run(ctx, c, positional)
+}
+
+// findCommand returns the command with the longest path which the arguments start with.
+func (c *cli) findCommand(args []string) *command {
+	var found *command
+	for _, cmd := range c.commands {
+		if len(cmd.path) > len(args) || (found != nil && len(found.path) >= len(cmd.path)) {
+			continue
+		}
+		matches := true
+		for i, word := range cmd.path {
+			matches = matches && args[i] == word
+		}
+		if matches {
+			found = cmd
+		}
+	}
+	return found
+}
+
+// parseInterspersed parses flags which may appear before, between or after the positional arguments, and returns the
+// positional arguments.
+func parseInterspersed(flags *flag.FlagSet, args []string) (positional []string, err error) {
+	for {
+		err = flags.Parse(args)
+		if err != nil {
+			return
+		}
+		args = flags.Args()
+		if len(args) == 0 {
+			return
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// usage prints the list of commands.
+func (c *cli) usage() {
+	fmt.Fprint(c.stderr, "Usage: hpdb [flags] <command> [arguments]\n\nCommands:\n")
+	w := tabwriter.NewWriter(c.stderr, 0, 0, 2, ' ', 0)
+	for _, cmd := range c.commands {
+		fmt.Fprintf(w, "  %s\t%s\n", strings.Join(append(cmd.path, cmd.args...), " "), cmd.summary)
+	}
+	w.Flush()
+	fmt.Fprint(c.stderr, "\nFlags:\n")
+	flags := flag.NewFlagSet("hpdb", flag.ContinueOnError)
+	flags.SetOutput(c.stderr)
+	c.globalFlags(flags)
+	flags.PrintDefaults()
+	fmt.Fprint(c.stderr, "\nRun \"hpdb <command> -h\" for the flags of a command.\n")
+}
+
+// client returns the HpdbV3 client, which is configured from the external configuration and the CRN of the cluster.
+func (c *cli) client() (*hpdbv3.HpdbV3, error) {
+	if c.hpdb != nil {
+		return c.hpdb, nil
+	}
+	hpdb, err := hpdbv3.NewHpdbV3UsingExternalConfig(&hpdbv3.HpdbV3Options{ServiceName: c.serviceName})
+	if err != nil {
+		return nil, err
+	}
+	if c.crn != "" {
+		clusterCRN, err := hpdbv3.ParseClusterCRN(c.crn)
+		if err != nil {
+			return nil, err
+		}
+		if err = setServiceURL(hpdb, clusterCRN); err != nil {
+			return nil, err
+		}
+	}
+	c.hpdb = hpdb
+	return hpdb, nil
+}
+
+// setServiceURL sets the service URL of the account of a cluster. The endpoint is the one of the configured URL, or the
+// one of the region of the cluster if no URL is configured.
+func setServiceURL(hpdb *hpdbv3.HpdbV3, clusterCRN *hpdbv3.ClusterCRN) error {
+	if hpdb.GetServiceURL() == hpdbv3.DefaultServiceURL {
+		serviceURL, err := clusterCRN.ServiceURL("")
+		if err != nil {
+			return err
+		}
+		return hpdb.SetServiceURL(serviceURL)
+	}
+	serviceURL, err := url.Parse(hpdb.GetServiceURL())
+	if err != nil {
+		return err
+	}
+	serviceURL.Path = "/api/v3/" + clusterCRN.AccountID
+	return hpdb.SetServiceURL(serviceURL.String())
+}
+
+// cluster returns the ID of the cluster selected by the --crn or --cluster-id flag.
+func (c *cli) cluster() (string, error) {
+	if c.crn != "" {
+		clusterCRN, err := hpdbv3.ParseClusterCRN(c.crn)
+		if err != nil {
+			return "", err
+		}
+		return clusterCRN.ClusterID, nil
+	}
+	if c.clusterID != "" {
+		return c.clusterID, nil
+	}
+	return "", errors.New("no cluster selected: use --crn or --cluster-id")
+}
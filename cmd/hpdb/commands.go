@@ -0,0 +1,867 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3/cosbackup"
+)
+
+// commands returns every command of the command line, in the order they are listed by the usage.
+func commands() []*command {
+	return []*command{
+		{
+			path:    []string{"cluster", "get"},
+			summary: "Show the details of the database cluster",
+			run:     getCluster,
+		},
+		{
+			path:    []string{"users", "list"},
+			summary: "List the database users",
+			run:     listUsers,
+		},
+		{
+			path:    []string{"users", "get"},
+			args:    []string{"USER_ID"},
+			summary: "Show the details of a database user; MongoDB users are identified by AUTH_DB.NAME",
+			run:     getUser,
+		},
+		{
+			path:    []string{"databases", "list"},
+			summary: "List the databases",
+			run:     listDatabases,
+		},
+		{
+			path:    []string{"tasks", "list"},
+			summary: "List the tasks",
+			run:     listTasks,
+		},
+		{
+			path:    []string{"tasks", "get"},
+			args:    []string{"TASK_ID"},
+			summary: "Show the details of a task",
+			run:     getTask,
+		},
+		waitTaskCommand(),
+		{
+			path:    []string{"config", "get"},
+			summary: "Show the database configuration (only for PostgreSQL)",
+			run:     getConfiguration,
+		},
+		setConfigurationCommand(),
+		{
+			path:    []string{"config", "export"},
+			summary: "Print the database configuration in the postgresql.conf format (only for PostgreSQL)",
+			run:     exportConfiguration,
+		},
+		importConfigurationCommand(),
+		{
+			path:    []string{"backups", "list"},
+			summary: "List the backups",
+			run:     listBackups,
+		},
+		enableCosBackupCommand(),
+		disableCosBackupCommand(),
+		{
+			path:    []string{"backups", "config"},
+			summary: "Show the backup configuration",
+			run:     getBackupConfig,
+		},
+		updateBackupConfigCommand(),
+		checkRPOCommand(),
+		restoreCommand(),
+		scaleCommand(),
+		{
+			path:    []string{"logs", "list"},
+			args:    []string{"NODE_ID"},
+			summary: "List the log files of a database node",
+			run:     listNodeLogs,
+		},
+		getLogCommand(),
+	}
+}
+
+// waitFlags holds the flags of the commands which start a task.
+type waitFlags struct {
+	wait     bool
+	interval time.Duration
+}
+
+// register registers the flags which wait for the task started by a command.
+func (w *waitFlags) register(flags *flag.FlagSet) {
+	flags.BoolVar(&w.wait, "wait", false, "wait for the task to finish and show it, instead of showing its ID")
+	flags.DurationVar(&w.interval, "interval", hpdbv3.DefaultTaskPollInterval, "the `interval` between two polls of the task when waiting")
+}
+
+// finishTask prints the task started by a command, or waits for it if the --wait flag is set.
+func (c *cli) finishTask(ctx context.Context, clusterID string, taskID *hpdbv3.TaskID, w *waitFlags) error {
+	if !w.wait {
+		return c.printTaskID(taskID)
+	}
+	if taskID == nil || taskID.TaskID == nil {
+		return errors.New("the response does not contain a task ID")
+	}
+	return c.waitForTask(ctx, clusterID, *taskID.TaskID, w.interval)
+}
+
+// waitForTask waits for a task to finish and prints it. A task which failed is printed before its error is returned.
+func (c *cli) waitForTask(ctx context.Context, clusterID string, taskID string, interval time.Duration) error {
+	hpdb, err := c.client()
+	if err != nil {
+		return err
+	}
+	task, err := hpdb.WaitForTask(ctx, clusterID, taskID, hpdb.NewWaitForTaskOptions().SetInterval(interval))
+	var taskFailedErr *hpdbv3.TaskFailedError
+	if err != nil && !errors.As(err, &taskFailedErr) {
+		return err
+	}
+	if printErr := c.printTask(task); printErr != nil {
+		return printErr
+	}
+	return err
+}
+
+// clientAndCluster returns the client and the ID of the selected cluster.
+func (c *cli) clientAndCluster() (*hpdbv3.HpdbV3, string, error) {
+	clusterID, err := c.cluster()
+	if err != nil {
+		return nil, "", err
+	}
+	hpdb, err := c.client()
+	if err != nil {
+		return nil, "", err
+	}
+	return hpdb, clusterID, nil
+}
+
+func getCluster(ctx context.Context, c *cli, args []string) error {
+	hpdb, clusterID, err := c.clientAndCluster()
+	if err != nil {
+		return err
+	}
+	cluster, _, err := hpdb.GetClusterWithContext(ctx, hpdb.NewGetClusterOptions(clusterID))
+	if err != nil {
+		return err
+	}
+	return c.print(cluster, func(t *table) {
+		t.row("ID", str(cluster.ID))
+		t.row("NAME", str(cluster.Name))
+		t.row("CRN", str(cluster.Crn))
+		t.row("REGION", str(cluster.Region))
+		t.row("STATE", str(cluster.State))
+		if cluster.Reason != nil && *cluster.Reason != "" {
+			t.row("REASON", *cluster.Reason)
+		}
+		t.row("DB TYPE", str(cluster.DbType))
+		t.row("DB VERSION", str(cluster.DbVersion))
+		t.row("PLAN", str(cluster.PlanID))
+		t.row("PUBLIC ENDPOINT", str(cluster.PublicEndpoint))
+		t.row("PRIVATE ENDPOINT", str(cluster.PrivateEndpoint))
+		if cluster.Resource != nil {
+			t.row("CPU", integer(cluster.Resource.Cpu))
+			t.row("MEMORY", str(cluster.Resource.Memory))
+			t.row("STORAGE", str(cluster.Resource.Storage))
+			storageUsed := str(cluster.Resource.StorageUsed)
+			if utilization, err := cluster.Resource.StorageUtilization(); err == nil && cluster.Resource.StorageUsed != nil {
+				storageUsed += fmt.Sprintf(" (%.0f%%)", utilization*100)
+			}
+			t.row("STORAGE USED", storageUsed)
+		}
+		t.row("COS BACKUP", boolean(cluster.IsCosBackupEnabled))
+		for _, node := range cluster.Nodes {
+			t.row("NODE "+str(node.ID), str(node.ReplicaState)+" "+str(node.NodeState))
+		}
+	})
+}
+
+func listUsers(ctx context.Context, c *cli, args []string) error {
+	hpdb, clusterID, err := c.clientAndCluster()
+	if err != nil {
+		return err
+	}
+	users, _, err := hpdb.ListUsersWithContext(ctx, hpdb.NewListUsersOptions(clusterID))
+	if err != nil {
+		return err
+	}
+	return c.print(users, func(t *table) {
+		t.header = []string{"NAME", "AUTH DB", "ROLE ATTRIBUTES"}
+		for _, user := range users.Users {
+			t.row(str(user.Name), str(user.AuthDb), list(user.RoleAttributes))
+		}
+	})
+}
+
+func getUser(ctx context.Context, c *cli, args []string) error {
+	hpdb, clusterID, err := c.clientAndCluster()
+	if err != nil {
+		return err
+	}
+	user, _, err := hpdb.GetUserWithContext(ctx, hpdb.NewGetUserOptions(clusterID, args[0]))
+	if err != nil {
+		return err
+	}
+	return c.print(user, func(t *table) {
+		t.row("NAME", str(user.Name))
+		t.row("AUTH DB", str(user.AuthDb))
+		t.row("ROLE ATTRIBUTES", list(user.RoleAttributes))
+		for _, access := range user.DbAccess {
+			t.row("ACCESS "+str(access.Db), list(access.Privileges))
+		}
+	})
+}
+
+func listDatabases(ctx context.Context, c *cli, args []string) error {
+	hpdb, clusterID, err := c.clientAndCluster()
+	if err != nil {
+		return err
+	}
+	databases, _, err := hpdb.ListDatabasesWithContext(ctx, hpdb.NewListDatabasesOptions(clusterID))
+	if err != nil {
+		return err
+	}
+	return c.print(databases, func(t *table) {
+		t.header = []string{"NAME", "SIZE ON DISK"}
+		for _, database := range databases.Databases {
+			t.row(str(database.Name), integer(database.SizeOnDisk))
+		}
+	})
+}
+
+func listTasks(ctx context.Context, c *cli, args []string) error {
+	hpdb, clusterID, err := c.clientAndCluster()
+	if err != nil {
+		return err
+	}
+	tasks, _, err := hpdb.ListTasksWithContext(ctx, hpdb.NewListTasksOptions(clusterID))
+	if err != nil {
+		return err
+	}
+	return c.print(tasks, func(t *table) {
+		t.header = []string{"ID", "TYPE", "STATE", "STARTED AT", "FINISHED AT", "REASON"}
+		for _, task := range tasks.Tasks {
+			t.row(str(task.ID), str(task.Type), str(task.State), str(task.StartedAt), str(task.FinishedAt), str(task.Reason))
+		}
+	})
+}
+
+func getTask(ctx context.Context, c *cli, args []string) error {
+	hpdb, clusterID, err := c.clientAndCluster()
+	if err != nil {
+		return err
+	}
+	task, _, err := hpdb.GetTaskWithContext(ctx, hpdb.NewGetTaskOptions(clusterID, args[0]))
+	if err != nil {
+		return err
+	}
+	return c.printTask(task)
+}
+
+// printTask prints the details of a task.
+func (c *cli) printTask(task *hpdbv3.Task) error {
+	return c.print(task, func(t *table) {
+		t.row("ID", str(task.ID))
+		t.row("TYPE", str(task.Type))
+		t.row("STATE", str(task.State))
+		t.row("REASON", str(task.Reason))
+		t.row("STARTED AT", str(task.StartedAt))
+		t.row("FINISHED AT", str(task.FinishedAt))
+		for _, node := range task.Nodes {
+			state := str(node.State)
+			if node.Reason != nil && *node.Reason != "" {
+				state += " (" + *node.Reason + ")"
+			}
+			t.row("NODE "+str(node.ID), state)
+		}
+	})
+}
+
+func waitTaskCommand() *command {
+	var interval time.Duration
+	return &command{
+		path:    []string{"tasks", "wait"},
+		args:    []string{"TASK_ID"},
+		summary: "Wait for a task to finish and show it",
+		flags: func(flags *flag.FlagSet) {
+			flags.DurationVar(&interval, "interval", hpdbv3.DefaultTaskPollInterval, "the `interval` between two polls of the task")
+		},
+		run: func(ctx context.Context, c *cli, args []string) error {
+			clusterID, err := c.cluster()
+			if err != nil {
+				return err
+			}
+			return c.waitForTask(ctx, clusterID, args[0], interval)
+		},
+	}
+}
+
+func getConfiguration(ctx context.Context, c *cli, args []string) error {
+	hpdb, clusterID, err := c.clientAndCluster()
+	if err != nil {
+		return err
+	}
+	configuration, _, err := hpdb.GetConfigurationWithContext(ctx, hpdb.NewGetConfigurationOptions(clusterID))
+	if err != nil {
+		return err
+	}
+	return c.print(configuration, func(t *table) {
+		t.header = []string{"NAME", "VALUE", "DEFAULT", "MIN", "MAX", "REQUIRES RESTART"}
+		item := configuration.Configuration
+		for _, name := range item.ParameterNames() {
+			p := item.GetParameter(name)
+			t.row(name, parameterValue(p.Value), parameterValue(p.Default), integer(p.Min), integer(p.Max), boolean(p.RequiresRestart))
+		}
+	})
+}
+
+func setConfigurationCommand() *command {
+	var w waitFlags
+	return &command{
+		path:    []string{"config", "set"},
+		args:    []string{"NAME=VALUE"},
+		summary: "Update a database configuration parameter (only for PostgreSQL), such as max_connections=200",
+		flags:   w.register,
+		run: func(ctx context.Context, c *cli, args []string) error {
+			configuration, err := parseConfiguration(args[0])
+			if err != nil {
+				return err
+			}
+			hpdb, clusterID, err := c.clientAndCluster()
+			if err != nil {
+				return err
+			}
+			options := hpdb.NewUpdateConfigurationOptions(clusterID).SetConfiguration(configuration)
+			taskID, _, err := hpdb.UpdateConfigurationWithContext(ctx, options)
+			if err != nil {
+				return err
+			}
+			return c.finishTask(ctx, clusterID, taskID, &w)
+		},
+	}
+}
+
+func exportConfiguration(ctx context.Context, c *cli, args []string) error {
+	hpdb, clusterID, err := c.clientAndCluster()
+	if err != nil {
+		return err
+	}
+	configuration, _, err := hpdb.GetConfigurationWithContext(ctx, hpdb.NewGetConfigurationOptions(clusterID))
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprint(c.stdout, hpdbv3.FormatPostgresqlConf(configuration))
+	return err
+}
+
+func importConfigurationCommand() *command {
+	var w waitFlags
+	var dryRun bool
+	return &command{
+		path:    []string{"config", "import"},
+		args:    []string{"FILE"},
+		summary: "Update the database configuration from a file in the postgresql.conf format (only for PostgreSQL)",
+		flags: func(flags *flag.FlagSet) {
+			flags.BoolVar(&dryRun, "dry-run", false, "show the changes without updating the configuration")
+			w.register(flags)
+		},
+		run: func(ctx context.Context, c *cli, args []string) error {
+			text, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			hpdb, clusterID, err := c.clientAndCluster()
+			if err != nil {
+				return err
+			}
+			current, _, err := hpdb.GetConfigurationWithContext(ctx, hpdb.NewGetConfigurationOptions(clusterID))
+			if err != nil {
+				return err
+			}
+			desired, err := hpdbv3.ParsePostgresqlConf(string(text), current)
+			if err != nil {
+				return fmt.Errorf("%s: %w", args[0], err)
+			}
+			plan, err := hpdb.NewConfigurationPlanner(clusterID, desired).Plan(ctx)
+			if err != nil {
+				return err
+			}
+			fmt.Fprint(c.stderr, plan.String())
+			if dryRun || plan.IsEmpty() {
+				return nil
+			}
+
+			// Only the changed parameters are updated
+			configuration := &hpdbv3.Configurations{}
+			for _, change := range plan.Changes {
+				configuration.SetParameter(change.Name, change.To)
+			}
+			options := hpdb.NewUpdateConfigurationOptions(clusterID).SetConfiguration(configuration)
+			taskID, _, err := hpdb.UpdateConfigurationWithContext(ctx, options)
+			if err != nil {
+				return err
+			}
+			return c.finishTask(ctx, clusterID, taskID, &w)
+		},
+	}
+}
+
+// parseConfiguration parses a NAME=VALUE argument into the configuration to update.
+func parseConfiguration(arg string) (*hpdbv3.Configurations, error) {
+	name, value, found := strings.Cut(arg, "=")
+	if !found || name == "" {
+		return nil, fmt.Errorf("invalid configuration %q: use NAME=VALUE", arg)
+	}
+	return (&hpdbv3.Configurations{}).SetParameter(name, hpdbv3.ParseParameterValue(value)), nil
+}
+
+func listBackups(ctx context.Context, c *cli, args []string) error {
+	hpdb, clusterID, err := c.clientAndCluster()
+	if err != nil {
+		return err
+	}
+	backups, _, err := hpdb.ListBackupsWithContext(ctx, hpdb.NewListBackupsOptions(clusterID))
+	if err != nil {
+		return err
+	}
+	return c.print(backups, func(t *table) {
+		t.header = []string{"ID", "TYPE", "CREATED AT", "BACKUP FILE"}
+		for _, backup := range backups.Backups {
+			backupFile := ""
+			if file, err := backup.GetBackupFile(); err == nil {
+				backupFile = file.Name
+			}
+			t.row(str(backup.ID), str(backup.Type), str(backup.CreatedAt), backupFile)
+		}
+	})
+}
+
+// cosFlags holds the flags which describe a COS bucket.
+type cosFlags struct {
+	endpoint          string
+	bucketInstanceCrn string
+	accessKeyID       string
+	secretAccessKey   string
+	scheduleType      string
+	scheduleValue     string
+}
+
+// register registers the flags which describe a COS bucket.
+func (f *cosFlags) register(flags *flag.FlagSet, schedule bool) {
+	flags.StringVar(&f.endpoint, "cos-endpoint", "", "the `endpoint` of the COS bucket")
+	flags.StringVar(&f.bucketInstanceCrn, "bucket-instance-crn", "", "the `CRN` of the COS bucket")
+	flags.StringVar(&f.accessKeyID, "access-key-id", "", "the HMAC access key `ID` of the COS bucket (default $COS_ACCESS_KEY_ID)")
+	flags.StringVar(&f.secretAccessKey, "secret-access-key", "", "the HMAC secret access `key` of the COS bucket (default $COS_SECRET_ACCESS_KEY)")
+	if schedule {
+		flags.StringVar(&f.scheduleType, "schedule-type", "", "the `type` of the backup schedule, such as frequency")
+		flags.StringVar(&f.scheduleValue, "schedule-value", "", "the `value` of the backup schedule, such as 8h")
+	}
+}
+
+// hmacKeys returns the HMAC keys given by the flags, or nil if none is given. The keys default to the
+// COS_ACCESS_KEY_ID and COS_SECRET_ACCESS_KEY environment variables, so that the secret does not need to appear on the
+// command line.
+func (f *cosFlags) hmacKeys() *hpdbv3.CosHmacKeys {
+	accessKeyID, secretAccessKey := f.accessKeyID, f.secretAccessKey
+	if accessKeyID == "" {
+		accessKeyID = os.Getenv("COS_ACCESS_KEY_ID")
+	}
+	if secretAccessKey == "" {
+		secretAccessKey = os.Getenv("COS_SECRET_ACCESS_KEY")
+	}
+	if accessKeyID == "" && secretAccessKey == "" {
+		return nil
+	}
+	return &hpdbv3.CosHmacKeys{
+		AccessKeyID:     core.StringPtr(accessKeyID),
+		SecretAccessKey: core.StringPtr(secretAccessKey),
+	}
+}
+
+// schedule returns the backup schedule given by the flags, or nil if none is given.
+func (f *cosFlags) schedule() *hpdbv3.BackupSchedule {
+	if f.scheduleType == "" && f.scheduleValue == "" {
+		return nil
+	}
+	schedule := &hpdbv3.BackupSchedule{}
+	if f.scheduleType != "" {
+		schedule.Type = core.StringPtr(f.scheduleType)
+	}
+	if f.scheduleValue != "" {
+		schedule.Value = core.StringPtr(f.scheduleValue)
+	}
+	return schedule
+}
+
+// optional returns a pointer to s, or nil if s is empty.
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func enableCosBackupCommand() *command {
+	var w waitFlags
+	var cos cosFlags
+	return &command{
+		path:    []string{"backups", "enable"},
+		summary: "Enable backups to COS",
+		flags: func(flags *flag.FlagSet) {
+			cos.register(flags, true)
+			w.register(flags)
+		},
+		run: func(ctx context.Context, c *cli, args []string) error {
+			hpdb, clusterID, err := c.clientAndCluster()
+			if err != nil {
+				return err
+			}
+			options := hpdb.NewEnableCosBackupOptions(clusterID)
+			options.CosHmacKeys = cos.hmacKeys()
+			options.CosEndpoint = optional(cos.endpoint)
+			options.BucketInstanceCrn = optional(cos.bucketInstanceCrn)
+			options.Schedule = cos.schedule()
+			taskID, _, err := hpdb.EnableCosBackupWithContext(ctx, options)
+			if err != nil {
+				return err
+			}
+			return c.finishTask(ctx, clusterID, taskID, &w)
+		},
+	}
+}
+
+func disableCosBackupCommand() *command {
+	var w waitFlags
+	return &command{
+		path:    []string{"backups", "disable"},
+		summary: "Disable backups to COS",
+		flags:   w.register,
+		run: func(ctx context.Context, c *cli, args []string) error {
+			hpdb, clusterID, err := c.clientAndCluster()
+			if err != nil {
+				return err
+			}
+			taskID, _, err := hpdb.DisableCosBackupWithContext(ctx, hpdb.NewDisableCosBackupOptions(clusterID))
+			if err != nil {
+				return err
+			}
+			return c.finishTask(ctx, clusterID, taskID, &w)
+		},
+	}
+}
+
+func getBackupConfig(ctx context.Context, c *cli, args []string) error {
+	hpdb, clusterID, err := c.clientAndCluster()
+	if err != nil {
+		return err
+	}
+	config, _, err := hpdb.GetBackupConfigWithContext(ctx, hpdb.NewGetBackupConfigOptions(clusterID))
+	if err != nil {
+		return err
+	}
+	return c.print(config, func(t *table) {
+		if config.Cos == nil {
+			t.row("COS BACKUP", "false")
+			return
+		}
+		t.row("COS BACKUP", "true")
+		t.row("COS ENDPOINT", str(config.Cos.CosEndpoint))
+		t.row("BUCKET INSTANCE CRN", str(config.Cos.BucketInstanceCrn))
+		if config.Cos.Schedule != nil {
+			t.row("SCHEDULE", str(config.Cos.Schedule.Type)+" "+str(config.Cos.Schedule.Value))
+		}
+	})
+}
+
+func updateBackupConfigCommand() *command {
+	var w waitFlags
+	var cos cosFlags
+	return &command{
+		path:    []string{"backups", "config", "set"},
+		summary: "Update the backup configuration",
+		flags: func(flags *flag.FlagSet) {
+			cos.register(flags, true)
+			w.register(flags)
+		},
+		run: func(ctx context.Context, c *cli, args []string) error {
+			hpdb, clusterID, err := c.clientAndCluster()
+			if err != nil {
+				return err
+			}
+			options := hpdb.NewUpdateBackupConfigOptions(clusterID).SetCos(&hpdbv3.CosBackupConfig{
+				CosHmacKeys:       cos.hmacKeys(),
+				CosEndpoint:       optional(cos.endpoint),
+				BucketInstanceCrn: optional(cos.bucketInstanceCrn),
+				Schedule:          cos.schedule(),
+			})
+			taskID, _, err := hpdb.UpdateBackupConfigWithContext(ctx, options)
+			if err != nil {
+				return err
+			}
+			return c.finishTask(ctx, clusterID, taskID, &w)
+		},
+	}
+}
+
+func checkRPOCommand() *command {
+	gracePeriod := hpdbv3.DefaultRPOGracePeriod
+	return &command{
+		path:    []string{"backups", "rpo"},
+		summary: "Check that the latest backup meets the recovery point objective; exit with status 1 if it does not",
+		flags: func(flags *flag.FlagSet) {
+			flags.DurationVar(&gracePeriod, "grace-period", gracePeriod, "the `duration` a backup may be late")
+		},
+		run: func(ctx context.Context, c *cli, args []string) error {
+			hpdb, clusterID, err := c.clientAndCluster()
+			if err != nil {
+				return err
+			}
+			report, err := hpdb.NewRPOChecker(clusterID).SetGracePeriod(gracePeriod).Check(ctx)
+			if err != nil {
+				return err
+			}
+			err = c.print(report, func(t *table) {
+				t.row("STATUS", string(report.Status))
+				t.row("REASON", report.Reason)
+				t.row("COS BACKUP", fmt.Sprint(report.CosBackupEnabled))
+				if report.Interval != "" {
+					t.row("INTERVAL", string(report.Interval))
+					t.row("OBJECTIVE", report.Objective.String())
+				}
+				if report.LatestBackupAt != nil {
+					t.row("LATEST BACKUP", report.LatestBackupID+" "+report.LatestBackupAt.Format(time.RFC3339))
+				}
+				if report.NextBackupAt != nil {
+					t.row("NEXT BACKUP", report.NextBackupAt.Format(time.RFC3339))
+				}
+			})
+			if err != nil {
+				return err
+			}
+			if !report.IsCompliant() {
+				return fmt.Errorf("the recovery point objective is not met: %s", report.Reason)
+			}
+			return nil
+		},
+	}
+}
+
+func restoreCommand() *command {
+	var w waitFlags
+	var cos cosFlags
+	var sourceType, backupID, backupFile, pointInTime string
+	var dryRun bool
+	return &command{
+		path:    []string{"restore"},
+		summary: "Restore the database cluster from a backup, or from a backup file in COS",
+		flags: func(flags *flag.FlagSet) {
+			flags.StringVar(&sourceType, "source-type", "", "the `type` of the backup source: default or cos (default: cos if --backup-file is set)")
+			flags.StringVar(&backupID, "backup-id", "", "the `ID` of the backup, as shown by \"backups list\"")
+			flags.StringVar(&backupFile, "backup-file", "", "the `name` of the backup file in COS")
+			flags.StringVar(&pointInTime, "time", "", "restore the most recent backup created at or before this `time`, in RFC 3339 format")
+			flags.BoolVar(&dryRun, "dry-run", false, "with --time, show the backup to restore without restoring it")
+			cos.register(flags, false)
+			w.register(flags)
+		},
+		run: func(ctx context.Context, c *cli, args []string) error {
+			if pointInTime != "" && (backupID != "" || backupFile != "") {
+				return errors.New("--time cannot be used with --backup-id or --backup-file")
+			}
+			if sourceType == "" {
+				sourceType = "default"
+				if backupFile != "" {
+					sourceType = "cos"
+				}
+			}
+			hpdb, clusterID, err := c.clientAndCluster()
+			if err != nil {
+				return err
+			}
+			options := hpdb.NewRestoreOptions(clusterID).SetSourceType(sourceType)
+			options.BackupID = optional(backupID)
+			options.BackupFile = optional(backupFile)
+			options.CosHmacKeys = cos.hmacKeys()
+			options.CosEndpoint = optional(cos.endpoint)
+			options.BucketInstanceCrn = optional(cos.bucketInstanceCrn)
+			if pointInTime != "" {
+				t, err := time.Parse(time.RFC3339, pointInTime)
+				if err != nil {
+					return fmt.Errorf("invalid --time: %w", err)
+				}
+				planner := hpdb.NewRestorePlanner(clusterID, t).SetSourceType(sourceType)
+				planner.SetCosHmacKeys(options.CosHmacKeys)
+				if options.CosEndpoint != nil {
+					planner.SetCosEndpoint(*options.CosEndpoint)
+				}
+				if options.BucketInstanceCrn != nil {
+					planner.SetBucketInstanceCrn(*options.BucketInstanceCrn)
+				}
+				if sourceType == string(hpdbv3.RestoreSourceTypeCos) {
+					// Only the backup files which exist in the bucket are restored
+					backupFiles, err := listBackupFiles(ctx, options)
+					if err != nil {
+						return err
+					}
+					planner.SetBackupFiles(backupFiles)
+				}
+				plan, err := planner.Plan(ctx)
+				if err != nil {
+					return err
+				}
+				fmt.Fprint(c.stderr, plan.String())
+				if dryRun {
+					return nil
+				}
+				options = plan.Options
+			}
+			taskID, _, err := hpdb.RestoreWithContext(ctx, options)
+			if err != nil {
+				return err
+			}
+			return c.finishTask(ctx, clusterID, taskID, &w)
+		},
+	}
+}
+
+// listBackupFiles lists the backup files in the COS bucket of the restore options.
+func listBackupFiles(ctx context.Context, options *hpdbv3.RestoreOptions) (hpdbv3.BackupFiles, error) {
+	client, err := cosbackup.New(&cosbackup.Options{
+		Config: &hpdbv3.CosBackupConfig{
+			CosHmacKeys:       options.CosHmacKeys,
+			CosEndpoint:       options.CosEndpoint,
+			BucketInstanceCrn: options.BucketInstanceCrn,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot list the backup files in COS: %w", err)
+	}
+	objects, err := client.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	backupFiles := make(hpdbv3.BackupFiles, 0, len(objects))
+	for _, object := range objects {
+		backupFiles = append(backupFiles, object.BackupFile)
+	}
+	return backupFiles, nil
+}
+
+func scaleCommand() *command {
+	var w waitFlags
+	var cpu int64
+	var memory, storage string
+	var noValidate bool
+	return &command{
+		path:    []string{"scale"},
+		summary: "Scale the resources of the database cluster",
+		flags: func(flags *flag.FlagSet) {
+			flags.Int64Var(&cpu, "cpu", 0, "the `number` of CPUs")
+			flags.StringVar(&memory, "memory", "", "the `size` of the memory, such as 4GiB")
+			flags.StringVar(&storage, "storage", "", "the `size` of the storage, such as 10GiB")
+			flags.BoolVar(&noValidate, "no-validate", false, "send the resources to the service without checking them against the allowed values")
+			w.register(flags)
+		},
+		run: func(ctx context.Context, c *cli, args []string) error {
+			resource := &hpdbv3.Resources{
+				Memory:  optional(memory),
+				Storage: optional(storage),
+			}
+			if cpu != 0 {
+				resource.Cpu = core.Int64Ptr(cpu)
+			}
+			if resource.Cpu == nil && resource.Memory == nil && resource.Storage == nil {
+				return errors.New("nothing to scale: use --cpu, --memory or --storage")
+			}
+			hpdb, clusterID, err := c.clientAndCluster()
+			if err != nil {
+				return err
+			}
+			if !noValidate {
+				hpdb.EnableResourceValidation()
+			}
+			options := hpdb.NewScaleResourcesOptions(clusterID).SetResource(resource)
+			taskID, _, err := hpdb.ScaleResourcesWithContext(ctx, options)
+			if err != nil {
+				return err
+			}
+			return c.finishTask(ctx, clusterID, taskID, &w)
+		},
+	}
+}
+
+func listNodeLogs(ctx context.Context, c *cli, args []string) error {
+	hpdb, err := c.client()
+	if err != nil {
+		return err
+	}
+	logs, _, err := hpdb.ListNodeLogsWithContext(ctx, hpdb.NewListNodeLogsOptions(args[0]))
+	if err != nil {
+		return err
+	}
+	return c.print(logs, func(t *table) {
+		t.header = []string{"FILENAME", "SIZE", "LAST MODIFIED"}
+		for _, log := range logs.Logs {
+			t.row(str(log.Filename), integer(log.Size), str(log.LastModified))
+		}
+	})
+}
+
+func getLogCommand() *command {
+	var file string
+	return &command{
+		path:    []string{"logs", "get"},
+		args:    []string{"NODE_ID", "LOG_NAME"},
+		summary: "Download a log file of a database node; the --output flag does not apply",
+		flags: func(flags *flag.FlagSet) {
+			flags.StringVar(&file, "file", "", "the `path` of the file to write the log to (default: standard output)")
+		},
+		run: func(ctx context.Context, c *cli, args []string) error {
+			hpdb, err := c.client()
+			if err != nil {
+				return err
+			}
+			options := hpdb.NewGetLogOptions(args[0], args[1]).SetAccept("application/x-download")
+			content, _, err := hpdb.GetLogWithContext(ctx, options)
+			if err != nil {
+				return err
+			}
+			defer content.Close()
+
+			if file == "" {
+				_, err = io.Copy(c.stdout, content)
+				return err
+			}
+			f, err := os.Create(file)
+			if err != nil {
+				return err
+			}
+			if _, err = io.Copy(f, content); err != nil {
+				f.Close()
+				return err
+			}
+			return f.Close()
+		},
+	}
+}
@@ -0,0 +1,56 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command hpdb manages IBM Hyper Protect DBaaS database clusters from the command line.
+//
+// The client is configured from the same external configuration as hpdbv3.NewHpdbV3UsingExternalConfig: the HPDB_*
+// environment variables, or the hpdb entries of a credentials file, such as:
+//
+//	HPDB_URL=https://dbaas902.hyperp-dbaas.cloud.ibm.com:20000
+//	HPDB_AUTH_TYPE=iam
+//	HPDB_APIKEY=<api key>
+//
+// The cluster is selected with the --crn flag, which also provides the account ID of the service URL. When HPDB_URL is
+// not set, the endpoint of the region of the cluster is used:
+//
+//	hpdb --crn crn:v1:bluemix:public:hyperp-dbaas-postgresql:eu-de:a/<account>:<cluster>:: cluster get
+//	hpdb --crn $CRN --output yaml tasks list
+//	hpdb --crn $CRN scale --cpu 4 --wait
+//
+// Run "hpdb help" for the list of commands.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run runs the command line and returns the exit code of the process.
+func run(args []string, stdout io.Writer, stderr io.Writer) int {
+	c := newCLI(stdout, stderr)
+	if err := c.run(args); err != nil {
+		if err != errUsage {
+			fmt.Fprintf(stderr, "hpdb: %s\n", err)
+		}
+		return 1
+	}
+	return 0
+}
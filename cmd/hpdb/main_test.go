@@ -0,0 +1,396 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3/hpdbv3test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	clusterID = "9cebab98-afeb-4886-9a29-8e741716e7ff"
+	accountID = "23a24a3e3fe7a115473f07be1c44bdb5"
+	crn       = "crn:v1:bluemix:public:hyperp-dbaas-postgresql:us-south:a/" + accountID + ":" + clusterID + "::"
+)
+
+// newServer starts a fake service and points the external configuration of the command line at it.
+func newServer(t *testing.T) *hpdbv3test.Server {
+	server := hpdbv3test.NewServer()
+	t.Cleanup(server.Close)
+	server.AddCluster(hpdbv3test.NewCluster(clusterID))
+	server.SetTaskDuration(0)
+
+	t.Setenv("HPDB_URL", server.URL)
+	t.Setenv("HPDB_AUTH_TYPE", "noauth")
+	return server
+}
+
+// runCLI runs the command line and returns its exit code, standard output and standard error.
+func runCLI(args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	code := run(args, &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestClusterGet(t *testing.T) {
+	server := newServer(t)
+
+	code, stdout, stderr := runCLI("--crn", crn, "cluster", "get")
+	require.Equal(t, 0, code, stderr)
+	assert.Regexp(t, `ID\s+`+clusterID, stdout)
+	assert.Regexp(t, `STATE\s+RUNNING`, stdout)
+	assert.Regexp(t, `NODE `+clusterID+`-node-1\s+PRIMARY RUNNING`, stdout)
+	assert.Regexp(t, `STORAGE USED\s+1GiB \(10%\)`, stdout)
+
+	// The account ID of the CRN is part of the service URL
+	assert.Equal(t, []string{"GET /api/v3/" + accountID + "/clusters/" + clusterID}, server.Requests())
+}
+
+func TestOutputFormats(t *testing.T) {
+	newServer(t)
+
+	code, stdout, stderr := runCLI("cluster", "get", "--crn", crn, "--output", "json")
+	require.Equal(t, 0, code, stderr)
+	var cluster hpdbv3.Cluster
+	require.Nil(t, json.Unmarshal([]byte(stdout), &cluster))
+	assert.Equal(t, clusterID, *cluster.ID)
+
+	code, stdout, stderr = runCLI("--output", "yaml", "--crn", crn, "cluster", "get")
+	require.Equal(t, 0, code, stderr)
+	assert.True(t, strings.HasPrefix(stdout, "id: "+clusterID+"\n"), stdout)
+	var values map[string]interface{}
+	require.Nil(t, yaml.Unmarshal([]byte(stdout), &values))
+	assert.Equal(t, "us-south", values["region"])
+
+	code, _, stderr = runCLI("--output", "xml", "--crn", crn, "cluster", "get")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, `invalid output format "xml"`)
+}
+
+func TestUsersAndDatabases(t *testing.T) {
+	server := newServer(t)
+	server.AddUser(clusterID, hpdbv3.UserDetails{
+		Name:           core.StringPtr("admin"),
+		RoleAttributes: []string{"CREATEDB", "CREATEROLE"},
+		DbAccess:       []hpdbv3.Access{{Db: core.StringPtr("orders"), Privileges: []string{"CONNECT"}}},
+	})
+	server.AddDatabase(clusterID, hpdbv3.Database{Name: core.StringPtr("orders"), SizeOnDisk: core.Int64Ptr(int64(8192))})
+
+	code, stdout, stderr := runCLI("--crn", crn, "users", "list")
+	require.Equal(t, 0, code, stderr)
+	assert.Regexp(t, `NAME\s+AUTH DB\s+ROLE ATTRIBUTES`, stdout)
+	assert.Regexp(t, `admin\s+-\s+CREATEDB,CREATEROLE`, stdout)
+
+	code, stdout, stderr = runCLI("--crn", crn, "users", "get", "admin")
+	require.Equal(t, 0, code, stderr)
+	assert.Regexp(t, `ACCESS orders\s+CONNECT`, stdout)
+
+	code, stdout, stderr = runCLI("--crn", crn, "databases", "list")
+	require.Equal(t, 0, code, stderr)
+	assert.Regexp(t, `orders\s+8192`, stdout)
+}
+
+func TestScaleAndTasks(t *testing.T) {
+	server := newServer(t)
+
+	code, stdout, stderr := runCLI("--crn", crn, "scale", "--cpu", "4", "--memory", "8GiB", "--output", "json")
+	require.Equal(t, 0, code, stderr)
+	var taskID hpdbv3.TaskID
+	require.Nil(t, json.Unmarshal([]byte(stdout), &taskID))
+	cluster, _ := server.Cluster(clusterID)
+	assert.Equal(t, int64(4), *cluster.Resource.Cpu)
+	assert.Equal(t, "8GiB", *cluster.Resource.Memory)
+
+	code, stdout, stderr = runCLI("--crn", crn, "tasks", "list")
+	require.Equal(t, 0, code, stderr)
+	assert.Regexp(t, *taskID.TaskID+`\s+scale_resources\s+SUCCEEDED`, stdout)
+
+	code, stdout, stderr = runCLI("--crn", crn, "tasks", "get", *taskID.TaskID)
+	require.Equal(t, 0, code, stderr)
+	assert.Regexp(t, `STATE\s+SUCCEEDED`, stdout)
+
+	code, _, stderr = runCLI("--crn", crn, "scale")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "nothing to scale")
+
+	// The storage cannot shrink, unless the validation is skipped
+	code, _, stderr = runCLI("--crn", crn, "scale", "--storage", "5GiB")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "the nearest valid value is 10GiB")
+	code, _, stderr = runCLI("--crn", crn, "scale", "--storage", "5GiB", "--no-validate")
+	assert.Equal(t, 0, code, stderr)
+}
+
+func TestWait(t *testing.T) {
+	server := newServer(t)
+	server.SetTaskDuration(time.Minute)
+	server.AdvanceOnRequest(20 * time.Second)
+
+	code, stdout, stderr := runCLI("--crn", crn, "scale", "--storage", "16GiB", "--wait", "--interval", "1ms")
+	require.Equal(t, 0, code, stderr)
+	assert.Regexp(t, `STATE\s+SUCCEEDED`, stdout)
+
+	server.FailNextTaskOnNode(clusterID+"-node-3", "disk full")
+	code, stdout, stderr = runCLI("--crn", crn, "scale", "--cpu", "4")
+	require.Equal(t, 0, code, stderr)
+	taskID := strings.Fields(stdout)[2]
+
+	code, stdout, stderr = runCLI("--crn", crn, "tasks", "wait", taskID, "--interval", "1ms")
+	assert.Equal(t, 1, code)
+	assert.Regexp(t, `NODE `+clusterID+`-node-3\s+FAILED \(disk full\)`, stdout)
+	assert.Contains(t, stderr, "disk full")
+}
+
+func TestConfig(t *testing.T) {
+	server := newServer(t)
+
+	code, stdout, stderr := runCLI("--crn", crn, "config", "get")
+	require.Equal(t, 0, code, stderr)
+	assert.Regexp(t, `max_connections\s+115\s+115\s+1\s+262143\s+true`, stdout)
+
+	code, _, stderr = runCLI("--crn", crn, "config", "set", "max_connections=200", "--wait", "--interval", "1ms")
+	require.Equal(t, 0, code, stderr)
+	tasks := server.Tasks(clusterID)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, "update_configuration", *tasks[0].Type)
+
+	code, stdout, stderr = runCLI("--crn", crn, "config", "get")
+	require.Equal(t, 0, code, stderr)
+	assert.Regexp(t, `max_connections\s+200\s+`, stdout)
+
+	// Parameters without a field in the SDK are supported too
+	assert.Regexp(t, `log_min_messages\s+warning\s+warning\s+-\s+-\s+false`, stdout)
+	code, _, stderr = runCLI("--crn", crn, "config", "set", "log_connections=true", "--wait", "--interval", "1ms")
+	require.Equal(t, 0, code, stderr)
+	code, stdout, stderr = runCLI("--crn", crn, "config", "get")
+	require.Equal(t, 0, code, stderr)
+	assert.Regexp(t, `log_connections\s+true\s+false\s+`, stdout)
+
+	code, _, stderr = runCLI("--crn", crn, "config", "set", "no_such_parameter=4096")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "unknown configuration parameter: no_such_parameter")
+
+	code, _, stderr = runCLI("--crn", crn, "config", "set", "=4096")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "use NAME=VALUE")
+}
+
+func TestConfigExportAndImport(t *testing.T) {
+	server := newServer(t)
+
+	code, stdout, stderr := runCLI("--crn", crn, "config", "export")
+	require.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, "shared_buffers = 128MB\t# (change requires restart)\n")
+	assert.Contains(t, stdout, "deadlock_timeout = 1s\n")
+
+	file := filepath.Join(t.TempDir(), "postgresql.conf")
+	conf := strings.Replace(stdout, "shared_buffers = 128MB", "shared_buffers = 256MB", 1)
+	require.Nil(t, os.WriteFile(file, []byte(conf+"log_min_messages = 'error'\n"), 0o600))
+
+	code, _, stderr = runCLI("--crn", crn, "config", "import", file, "--dry-run")
+	require.Equal(t, 0, code, stderr)
+	assert.Contains(t, stderr, "shared_buffers:   16384 -> 32768 (requires restart)")
+	assert.Contains(t, stderr, "log_min_messages: warning -> error")
+	assert.Empty(t, server.Tasks(clusterID))
+
+	code, _, stderr = runCLI("--crn", crn, "config", "import", file, "--wait", "--interval", "1ms")
+	require.Equal(t, 0, code, stderr)
+	tasks := server.Tasks(clusterID)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, "update_configuration", *tasks[0].Type)
+
+	// The configuration is up to date
+	code, _, stderr = runCLI("--crn", crn, "config", "import", file)
+	require.Equal(t, 0, code, stderr)
+	assert.Contains(t, stderr, "No changes")
+	assert.Len(t, server.Tasks(clusterID), 1)
+
+	require.Nil(t, os.WriteFile(file, []byte("shared_buffers = 1h\n"), 0o600))
+	code, _, stderr = runCLI("--crn", crn, "config", "import", file)
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "postgresql.conf: line 1: invalid value of shared_buffers")
+}
+
+func TestBackupsAndRestore(t *testing.T) {
+	server := newServer(t)
+	server.AddBackup(clusterID, hpdbv3.Backup{ID: core.StringPtr("backup-1"), Type: core.StringPtr("scheduled")})
+	t.Setenv("COS_ACCESS_KEY_ID", "access-key-id")
+	t.Setenv("COS_SECRET_ACCESS_KEY", "secret-access-key")
+
+	code, stdout, stderr := runCLI("--crn", crn, "backups", "list")
+	require.Equal(t, 0, code, stderr)
+	assert.Regexp(t, `backup-1\s+scheduled`, stdout)
+
+	code, _, stderr = runCLI("--crn", crn, "backups", "enable", "--cos-endpoint", "s3.us-south.cloud-object-storage.appdomain.cloud",
+		"--bucket-instance-crn", "crn:v1:bluemix:public:cloud-object-storage:global:a/"+accountID+":instance-id:bucket:backups")
+	require.Equal(t, 0, code, stderr)
+
+	code, _, stderr = runCLI("--crn", crn, "backups", "config", "set", "--schedule-type", "frequency", "--schedule-value", "12h")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, `invalid backup interval "12h"`)
+
+	code, _, stderr = runCLI("--crn", crn, "backups", "config", "set", "--schedule-type", "frequency", "--schedule-value", "1D")
+	require.Equal(t, 0, code, stderr)
+
+	code, stdout, stderr = runCLI("--crn", crn, "backups", "config")
+	require.Equal(t, 0, code, stderr)
+	assert.Regexp(t, `COS BACKUP\s+true`, stdout)
+	assert.Regexp(t, `SCHEDULE\s+frequency 1D`, stdout)
+
+	code, _, stderr = runCLI("--crn", crn, "backups", "disable")
+	require.Equal(t, 0, code, stderr)
+
+	code, _, stderr = runCLI("--crn", crn, "restore", "--backup-id", "backup-1")
+	require.Equal(t, 0, code, stderr)
+	assert.Equal(t, "restore", *server.Tasks(clusterID)[0].Type)
+}
+
+func TestRestoreToTime(t *testing.T) {
+	server := newServer(t)
+	server.AddBackup(clusterID, hpdbv3.Backup{ID: core.StringPtr("backup-1"), Type: core.StringPtr("scheduled"), CreatedAt: core.StringPtr("2026-03-01T08:00:00.000Z")})
+	server.AddBackup(clusterID, hpdbv3.Backup{ID: core.StringPtr("backup-2"), Type: core.StringPtr("scheduled"), CreatedAt: core.StringPtr("2026-03-02T08:00:00.000Z")})
+
+	code, stdout, stderr := runCLI("--crn", crn, "backups", "list")
+	require.Equal(t, 0, code, stderr)
+	assert.Regexp(t, `backup-1\s+scheduled\s+2026-03-01T08:00:00.000Z\s+2026-03-01-080000Z`, stdout)
+
+	code, _, stderr = runCLI("--crn", crn, "restore", "--time", "2026-03-02T07:00:00Z", "--dry-run")
+	require.Equal(t, 0, code, stderr)
+	assert.Contains(t, stderr, "backup-1 (scheduled) created at 2026-03-01T08:00:00Z")
+	assert.Empty(t, server.Tasks(clusterID))
+
+	// The backup file is selected among the objects in the bucket, whose names do not need to match the backups
+	cos := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/backups" || r.URL.Query().Get("list-type") != "2" {
+			http.NotFound(w, r)
+			return
+		}
+		io.WriteString(w, `<ListBucketResult><Contents><Key>2026-03-01-080001Z</Key><Size>1024</Size></Contents>`+
+			`<Contents><Key>2026-03-02-080001Z</Key><Size>1024</Size></Contents></ListBucketResult>`)
+	}))
+	defer cos.Close()
+	t.Setenv("COS_ACCESS_KEY_ID", "access-key-id")
+	t.Setenv("COS_SECRET_ACCESS_KEY", "secret-access-key")
+	code, _, stderr = runCLI("--crn", crn, "restore", "--time", "2026-03-02T07:00:00Z", "--source-type", "cos",
+		"--cos-endpoint", cos.URL,
+		"--bucket-instance-crn", "crn:v1:bluemix:public:cloud-object-storage:global:a/"+accountID+":instance-id:bucket:backups")
+	require.Equal(t, 0, code, stderr)
+	assert.Contains(t, stderr, "backup file 2026-03-01-080001Z")
+	assert.Equal(t, core.StringPtr("2026-03-01-080001Z"), server.Tasks(clusterID)[0].Spec["backup_file"])
+
+	code, _, stderr = runCLI("--crn", crn, "restore", "--time", "2026-03-01T00:00:00Z")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "has no backup created at or before 2026-03-01T00:00:00Z")
+
+	code, _, stderr = runCLI("--crn", crn, "restore", "--time", "2026-03-02T07:00:00Z", "--backup-id", "backup-1")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "--time cannot be used with --backup-id or --backup-file")
+}
+
+func TestBackupsRPO(t *testing.T) {
+	server := newServer(t)
+	server.UpdateCluster(clusterID, func(cluster *hpdbv3.Cluster) {
+		cluster.IsCosBackupEnabled = core.BoolPtr(true)
+	})
+
+	code, stdout, stderr := runCLI("--crn", crn, "backups", "rpo")
+	assert.Equal(t, 1, code)
+	assert.Regexp(t, `STATUS\s+no_backup`, stdout)
+	assert.Contains(t, stderr, "the recovery point objective is not met: the cluster has no backup")
+
+	server.AddBackup(clusterID, hpdbv3.Backup{ID: core.StringPtr("backup-1"), CreatedAt: core.StringPtr(time.Now().Add(-9 * time.Hour).Format(time.RFC3339))})
+	code, stdout, _ = runCLI("--crn", crn, "backups", "rpo", "--grace-period", "2h", "--output", "json")
+	require.Equal(t, 0, code)
+	var report map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(stdout), &report))
+	assert.Equal(t, "compliant", report["status"])
+	assert.Equal(t, "8h", report["interval"])
+	assert.Equal(t, "backup-1", report["latest_backup_id"])
+
+	code, stdout, _ = runCLI("--crn", crn, "backups", "rpo")
+	assert.Equal(t, 1, code)
+	assert.Regexp(t, `STATUS\s+violated`, stdout)
+}
+
+func TestLogs(t *testing.T) {
+	server := newServer(t)
+	nodeID := clusterID + "-node-1"
+	server.AddLog(nodeID, hpdbv3.Log{Filename: core.StringPtr("postgresql.log")}, []byte("LOG: database system is ready\n"))
+
+	code, stdout, stderr := runCLI("logs", "list", nodeID)
+	require.Equal(t, 0, code, stderr)
+	assert.Regexp(t, `postgresql.log\s+30`, stdout)
+
+	code, stdout, stderr = runCLI("logs", "get", nodeID, "postgresql.log")
+	require.Equal(t, 0, code, stderr)
+	assert.Equal(t, "LOG: database system is ready\n", stdout)
+
+	file := filepath.Join(t.TempDir(), "postgresql.log")
+	code, _, stderr = runCLI("logs", "get", "--file", file, nodeID, "postgresql.log")
+	require.Equal(t, 0, code, stderr)
+	content, err := os.ReadFile(file)
+	require.Nil(t, err)
+	assert.Equal(t, "LOG: database system is ready\n", string(content))
+}
+
+func TestUsage(t *testing.T) {
+	code, _, stderr := runCLI()
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "tasks wait TASK_ID")
+
+	code, _, stderr = runCLI("clusters", "get")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, `unknown command "clusters get"`)
+
+	code, _, stderr = runCLI("users", "get")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "users get requires 1 argument(s), got 0")
+
+	newServer(t)
+	code, _, stderr = runCLI("cluster", "get")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "no cluster selected")
+
+	code, _, stderr = runCLI("--crn", "crn:v1:bluemix", "cluster", "get")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "invalid CRN")
+}
+
+func TestServiceURLOfRegion(t *testing.T) {
+	t.Setenv("HPDB_AUTH_TYPE", "noauth")
+
+	c := newCLI(io.Discard, io.Discard)
+	c.crn = "crn:v1:bluemix:public:hyperp-dbaas-postgresql:eu-de:a/" + accountID + ":" + clusterID + "::"
+	hpdb, err := c.client()
+	require.Nil(t, err)
+	assert.Equal(t, "https://dbaas902.hyperp-dbaas.cloud.ibm.com:20000/api/v3/"+accountID, hpdb.GetServiceURL())
+}
@@ -0,0 +1,142 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+	"gopkg.in/yaml.v3"
+)
+
+// table : The rows printed by the table output format. A table without a header prints one "FIELD  VALUE" pair per
+// row.
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+// row adds a row to the table.
+func (t *table) row(cells ...string) {
+	t.rows = append(t.rows, cells)
+}
+
+// print writes the result of a command in the output format selected by the --output flag. The table format uses the
+// rows added by toTable.
+func (c *cli) print(result interface{}, toTable func(t *table)) error {
+	switch c.output {
+	case "json":
+		b, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(c.stdout, "%s\n", b)
+		return err
+	case "yaml":
+		b, err := toYAML(result)
+		if err != nil {
+			return err
+		}
+		_, err = c.stdout.Write(b)
+		return err
+	}
+
+	t := &table{}
+	toTable(t)
+	w := tabwriter.NewWriter(c.stdout, 0, 0, 3, ' ', 0)
+	if t.header != nil {
+		fmt.Fprintln(w, strings.Join(t.header, "\t"))
+	}
+	for _, row := range t.rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	return w.Flush()
+}
+
+// toYAML converts a result to YAML with the field names and field order of its JSON form.
+func toYAML(result interface{}) ([]byte, error) {
+	b, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	// JSON is valid YAML, so decoding it into a node keeps the order of the fields
+	var node yaml.Node
+	if err = yaml.Unmarshal(b, &node); err != nil {
+		return nil, err
+	}
+	clearStyle(&node)
+	return yaml.Marshal(&node)
+}
+
+// clearStyle removes the flow style of the JSON input, so that the nodes are written in block style.
+func clearStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		clearStyle(child)
+	}
+}
+
+// str formats an optional string for the table output format.
+func str(s *string) string {
+	if s == nil {
+		return "-"
+	}
+	return *s
+}
+
+// integer formats an optional integer for the table output format.
+func integer(i *int64) string {
+	if i == nil {
+		return "-"
+	}
+	return strconv.FormatInt(*i, 10)
+}
+
+// parameterValue formats an optional configuration parameter value for the table output format.
+func parameterValue(v hpdbv3.ParameterValue) string {
+	if !v.IsSet() {
+		return "-"
+	}
+	return v.String()
+}
+
+// boolean formats an optional boolean for the table output format.
+func boolean(b *bool) string {
+	if b == nil {
+		return "-"
+	}
+	return strconv.FormatBool(*b)
+}
+
+// list formats a list of strings for the table output format.
+func list(s []string) string {
+	if len(s) == 0 {
+		return "-"
+	}
+	return strings.Join(s, ",")
+}
+
+// printTaskID prints the ID of a task started by a command.
+func (c *cli) printTaskID(taskID *hpdbv3.TaskID) error {
+	return c.print(taskID, func(t *table) {
+		t.row("TASK ID", str(taskID.TaskID))
+	})
+}
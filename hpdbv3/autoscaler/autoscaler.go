@@ -0,0 +1,342 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package autoscaler grows the storage of a database cluster before it runs out.
+//
+// An Autoscaler periodically compares the used storage of a cluster, which is the larger of
+// ClusterResource.StorageUsed and the total size of its databases, with its storage. When the utilization reaches the
+// threshold of its Policy, it scales the storage to the next allowed tier with ScaleResources. Every check produces an
+// Event which describes the decision:
+//
+//	scaler, err := autoscaler.New(&autoscaler.Options{
+//		Client:    hpdb,
+//		ClusterID: clusterID,
+//		Policy:    autoscaler.Policy{Threshold: 0.8, CoolDown: time.Hour, MaxStorage: 512 * hpdbv3.GiB},
+//		OnEvent: func(event autoscaler.Event) {
+//			log.Printf("%s: %s", event.Type, event.Reason)
+//		},
+//	})
+//	...
+//	err = scaler.Run(ctx)
+package autoscaler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+)
+
+// DefaultInterval is the interval between two checks of the storage of the cluster.
+const DefaultInterval = 5 * time.Minute
+
+// Policy : When and how far the storage of a cluster is scaled.
+type Policy struct {
+	// The storage utilization, between 0 and 1, from which the storage is scaled, such as 0.8.
+	Threshold float64
+
+	// The minimum time between two scalings of the storage.
+	CoolDown time.Duration
+
+	// The largest storage the cluster can be scaled to. The largest allowed storage tier is used if this is zero.
+	MaxStorage hpdbv3.Quantity
+
+	// Whether the storage may be scaled by several tiers at once, to the smallest allowed tier which brings the
+	// utilization below the threshold. By default, it is scaled to the next allowed tier only.
+	SkipTiers bool
+}
+
+// validate checks the policy.
+func (policy *Policy) validate() error {
+	if policy.Threshold <= 0 || policy.Threshold > 1 {
+		return fmt.Errorf("invalid threshold %v: it must be greater than 0 and at most 1", policy.Threshold)
+	}
+	if policy.CoolDown < 0 {
+		return fmt.Errorf("invalid cool-down %s: it cannot be negative", policy.CoolDown)
+	}
+	if policy.MaxStorage < 0 {
+		return fmt.Errorf("invalid maximum storage %s: it cannot be negative", policy.MaxStorage)
+	}
+	return nil
+}
+
+// maxStorage returns the largest storage the cluster can be scaled to.
+func (policy *Policy) maxStorage() hpdbv3.Quantity {
+	if policy.MaxStorage > 0 {
+		return policy.MaxStorage
+	}
+	return hpdbv3.AllowedStorageSizes[len(hpdbv3.AllowedStorageSizes)-1]
+}
+
+// EventType : The decision made by a check of an Autoscaler.
+type EventType string
+
+// The types of the events of an Autoscaler.
+const (
+	// The storage utilization is below the threshold.
+	EventTypeNoAction EventType = "no_action"
+
+	// The storage was scaled.
+	EventTypeScaled EventType = "scaled"
+
+	// The storage should be scaled, but the previous scaling is too recent.
+	EventTypeCoolDown EventType = "cool_down"
+
+	// The storage should be scaled, but a task is running on the cluster.
+	EventTypeBusy EventType = "busy"
+
+	// The storage should be scaled, but it already reached the maximum storage of the policy.
+	EventTypeMaxReached EventType = "max_reached"
+
+	// The check failed.
+	EventTypeError EventType = "error"
+)
+
+// Event : The decision made by a check of an Autoscaler.
+type Event struct {
+	// The time of the check.
+	Time time.Time `json:"time"`
+
+	// The decision.
+	Type EventType `json:"type"`
+
+	// The ID of the cluster.
+	ClusterID string `json:"cluster_id"`
+
+	// The storage of the cluster.
+	Storage hpdbv3.Quantity `json:"storage,omitempty"`
+
+	// The used storage of the cluster, as reported in its resources.
+	StorageUsed hpdbv3.Quantity `json:"storage_used,omitempty"`
+
+	// The total size of the databases of the cluster.
+	DatabaseSize hpdbv3.Quantity `json:"database_size,omitempty"`
+
+	// The storage utilization, between 0 and 1.
+	Utilization float64 `json:"utilization,omitempty"`
+
+	// The storage the cluster is scaled to, for a scaled event.
+	Target hpdbv3.Quantity `json:"target,omitempty"`
+
+	// The ID of the ScaleResources task, for a scaled event.
+	TaskID string `json:"task_id,omitempty"`
+
+	// A human-readable description of the decision.
+	Reason string `json:"reason"`
+
+	// The error, for an error event.
+	Err error `json:"-"`
+}
+
+// Options : The options of an Autoscaler.
+type Options struct {
+	// The client of the service (required).
+	Client hpdbv3.HpdbV3API
+
+	// The ID of the cluster (required).
+	ClusterID string
+
+	// The scaling policy (required).
+	Policy Policy
+
+	// The interval between two checks. DefaultInterval is used if this is zero.
+	Interval time.Duration
+
+	// The source of time. The real time is used if this is nil.
+	Clock Clock
+
+	// The function which receives the event of every check.
+	OnEvent func(event Event)
+
+	// Allows users to set headers on API requests
+	Headers map[string]string
+}
+
+// Autoscaler : Scales the storage of a cluster according to a Policy.
+// An Autoscaler must not be used from multiple goroutines.
+type Autoscaler struct {
+	options    Options
+	lastScaled time.Time
+}
+
+// New returns an Autoscaler with the specified options.
+func New(options *Options) (*Autoscaler, error) {
+	if options == nil || options.Client == nil {
+		return nil, fmt.Errorf("the client of the autoscaler is required")
+	}
+	if options.ClusterID == "" {
+		return nil, fmt.Errorf("the cluster ID of the autoscaler is required")
+	}
+	if err := options.Policy.validate(); err != nil {
+		return nil, err
+	}
+	a := &Autoscaler{options: *options}
+	if a.options.Interval <= 0 {
+		a.options.Interval = DefaultInterval
+	}
+	if a.options.Clock == nil {
+		a.options.Clock = realClock{}
+	}
+	return a, nil
+}
+
+// Run checks the storage of the cluster immediately and then at every interval, until the context is done. The errors
+// of the checks are reported as events and do not stop Run. It returns the error of the context.
+func (a *Autoscaler) Run(ctx context.Context) error {
+	for {
+		a.Check(ctx)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-a.options.Clock.After(a.options.Interval):
+		}
+	}
+}
+
+// Check checks the storage of the cluster once, scales it if the policy requires it, and returns the event which
+// describes the decision. The event is also passed to the OnEvent function of the options.
+func (a *Autoscaler) Check(ctx context.Context) Event {
+	event := a.check(ctx)
+	if event.Err != nil {
+		event.Type = EventTypeError
+		event.Reason = event.Err.Error()
+	}
+	if a.options.OnEvent != nil {
+		a.options.OnEvent(event)
+	}
+	return event
+}
+
+func (a *Autoscaler) check(ctx context.Context) (event Event) {
+	event = Event{Time: a.options.Clock.Now(), ClusterID: a.options.ClusterID}
+
+	cluster, _, err := a.options.Client.GetClusterWithContext(ctx, &hpdbv3.GetClusterOptions{
+		ClusterID: core.StringPtr(a.options.ClusterID),
+		Headers:   a.options.Headers,
+	})
+	if err != nil {
+		event.Err = fmt.Errorf("error getting cluster %s: %w", a.options.ClusterID, err)
+		return
+	}
+	if cluster.Resource == nil {
+		event.Err = fmt.Errorf("the resources of cluster %s are unknown", a.options.ClusterID)
+		return
+	}
+	if event.Storage, err = cluster.Resource.GetStorage(); err != nil {
+		event.Err = err
+		return
+	}
+	if event.Storage == 0 {
+		event.Err = fmt.Errorf("the storage of cluster %s is unknown", a.options.ClusterID)
+		return
+	}
+	if event.StorageUsed, err = cluster.Resource.GetStorageUsed(); err != nil {
+		event.Err = err
+		return
+	}
+	databases, _, err := a.options.Client.ListDatabasesWithContext(ctx, &hpdbv3.ListDatabasesOptions{
+		ClusterID: core.StringPtr(a.options.ClusterID),
+		Headers:   a.options.Headers,
+	})
+	if err != nil {
+		event.Err = fmt.Errorf("error listing the databases of cluster %s: %w", a.options.ClusterID, err)
+		return
+	}
+	for _, database := range databases.Databases {
+		if database.SizeOnDisk != nil {
+			event.DatabaseSize += hpdbv3.Quantity(*database.SizeOnDisk)
+		}
+	}
+	used := event.StorageUsed
+	if event.DatabaseSize > used {
+		used = event.DatabaseSize
+	}
+	event.Utilization = used.Ratio(event.Storage)
+
+	policy := &a.options.Policy
+	if event.Utilization < policy.Threshold {
+		event.Type = EventTypeNoAction
+		event.Reason = fmt.Sprintf("storage utilization %.0f%% is below the threshold %.0f%%", event.Utilization*100, policy.Threshold*100)
+		return
+	}
+	target, ok := nextStorageTier(event.Storage, used, policy)
+	if !ok {
+		event.Type = EventTypeMaxReached
+		event.Reason = fmt.Sprintf("storage utilization %.0f%% reached the threshold %.0f%%, but storage %s cannot grow beyond the maximum %s",
+			event.Utilization*100, policy.Threshold*100, event.Storage, policy.maxStorage())
+		return
+	}
+	if !a.lastScaled.IsZero() && event.Time.Sub(a.lastScaled) < policy.CoolDown {
+		event.Type = EventTypeCoolDown
+		event.Reason = fmt.Sprintf("storage utilization %.0f%% reached the threshold %.0f%%, but the storage was scaled %s ago",
+			event.Utilization*100, policy.Threshold*100, event.Time.Sub(a.lastScaled))
+		return
+	}
+	tasks, _, err := a.options.Client.ListTasksWithContext(ctx, &hpdbv3.ListTasksOptions{
+		ClusterID: core.StringPtr(a.options.ClusterID),
+		Headers:   a.options.Headers,
+	})
+	if err != nil {
+		event.Err = fmt.Errorf("error listing the tasks of cluster %s: %w", a.options.ClusterID, err)
+		return
+	}
+	for i := range tasks.Tasks {
+		if tasks.Tasks[i].GetState() == hpdbv3.TaskStateRunning {
+			event.Type = EventTypeBusy
+			event.Reason = fmt.Sprintf("storage utilization %.0f%% reached the threshold %.0f%%, but task %s is running",
+				event.Utilization*100, policy.Threshold*100, core.StringNilMapper(tasks.Tasks[i].ID))
+			return
+		}
+	}
+
+	taskID, _, err := a.options.Client.ScaleResourcesWithContext(ctx, &hpdbv3.ScaleResourcesOptions{
+		ClusterID: core.StringPtr(a.options.ClusterID),
+		Resource:  &hpdbv3.Resources{Storage: hpdbv3.QuantityPtr(target)},
+		Headers:   a.options.Headers,
+	})
+	if err != nil {
+		event.Err = fmt.Errorf("error scaling the storage of cluster %s to %s: %w", a.options.ClusterID, target, err)
+		return
+	}
+	a.lastScaled = event.Time
+	event.Type = EventTypeScaled
+	event.Target = target
+	if taskID != nil {
+		event.TaskID = core.StringNilMapper(taskID.TaskID)
+	}
+	event.Reason = fmt.Sprintf("storage utilization %.0f%% reached the threshold %.0f%%: scaling storage from %s to %s",
+		event.Utilization*100, policy.Threshold*100, event.Storage, target)
+	return
+}
+
+// nextStorageTier returns the next allowed storage tier above the storage. If the policy skips tiers, it returns the
+// smallest allowed tier which keeps the used storage below the threshold instead, or the largest allowed tier within
+// the maximum storage if none does. It returns false if there is no allowed tier larger than the storage within the
+// maximum storage.
+func nextStorageTier(storage hpdbv3.Quantity, used hpdbv3.Quantity, policy *Policy) (target hpdbv3.Quantity, ok bool) {
+	for _, tier := range hpdbv3.AllowedStorageSizes {
+		if tier <= storage || tier > policy.maxStorage() {
+			continue
+		}
+		target, ok = tier, true
+		if !policy.SkipTiers || used.Ratio(tier) < policy.Threshold {
+			return
+		}
+	}
+	return
+}
@@ -0,0 +1,329 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package autoscaler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3/autoscaler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const clusterID = "9cebab98-afeb-4886-9a29-8e741716e7ff"
+
+// fakeClock is a Clock whose time only moves with Advance.
+type fakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []fakeWaiter
+	waiting chan struct{}
+}
+
+type fakeWaiter struct {
+	at time.Time
+	c  chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), waiting: make(chan struct{}, 100)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	w := fakeWaiter{at: c.now.Add(d), c: make(chan time.Time, 1)}
+	c.waiters = append(c.waiters, w)
+	c.waiting <- struct{}{}
+	return w.c
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	waiters := c.waiters[:0]
+	for _, w := range c.waiters {
+		if !w.at.After(c.now) {
+			w.c <- c.now
+		} else {
+			waiters = append(waiters, w)
+		}
+	}
+	c.waiters = waiters
+}
+
+// fakeClient is an HpdbV3API which serves a single cluster from memory. Only the methods used by the Autoscaler are
+// implemented.
+type fakeClient struct {
+	hpdbv3.HpdbV3API
+
+	storage      string
+	storageUsed  string
+	databases    []int64
+	runningTask  string
+	err          error
+	scaledTo     []string
+	requestCount int
+}
+
+func (f *fakeClient) GetClusterWithContext(ctx context.Context, options *hpdbv3.GetClusterOptions) (*hpdbv3.Cluster, *core.DetailedResponse, error) {
+	f.requestCount++
+	if f.err != nil {
+		return nil, nil, f.err
+	}
+	return &hpdbv3.Cluster{
+		ID: options.ClusterID,
+		Resource: &hpdbv3.ClusterResource{
+			Cpu:         core.Int64Ptr(2),
+			Memory:      core.StringPtr("4GiB"),
+			Storage:     core.StringPtr(f.storage),
+			StorageUsed: core.StringPtr(f.storageUsed),
+		},
+	}, &core.DetailedResponse{StatusCode: 200}, nil
+}
+
+func (f *fakeClient) ListDatabasesWithContext(ctx context.Context, options *hpdbv3.ListDatabasesOptions) (*hpdbv3.Databases, *core.DetailedResponse, error) {
+	f.requestCount++
+	result := &hpdbv3.Databases{}
+	for i, size := range f.databases {
+		result.Databases = append(result.Databases, hpdbv3.Database{Name: core.StringPtr(fmt.Sprintf("db%d", i)), SizeOnDisk: core.Int64Ptr(size)})
+	}
+	return result, &core.DetailedResponse{StatusCode: 200}, nil
+}
+
+func (f *fakeClient) ListTasksWithContext(ctx context.Context, options *hpdbv3.ListTasksOptions) (*hpdbv3.Tasks, *core.DetailedResponse, error) {
+	f.requestCount++
+	result := &hpdbv3.Tasks{Tasks: []hpdbv3.TaskItem{{ID: core.StringPtr("task-0"), State: core.StringPtr("SUCCEEDED")}}}
+	if f.runningTask != "" {
+		result.Tasks = append(result.Tasks, hpdbv3.TaskItem{ID: core.StringPtr(f.runningTask), State: core.StringPtr("RUNNING")})
+	}
+	return result, &core.DetailedResponse{StatusCode: 200}, nil
+}
+
+func (f *fakeClient) ScaleResourcesWithContext(ctx context.Context, options *hpdbv3.ScaleResourcesOptions) (*hpdbv3.TaskID, *core.DetailedResponse, error) {
+	f.requestCount++
+	f.storage = *options.Resource.Storage
+	f.scaledTo = append(f.scaledTo, f.storage)
+	return &hpdbv3.TaskID{TaskID: core.StringPtr(fmt.Sprintf("task-%d", len(f.scaledTo)))}, &core.DetailedResponse{StatusCode: 202}, nil
+}
+
+func newAutoscaler(t *testing.T, client *fakeClient, clock *fakeClock, policy autoscaler.Policy) (*autoscaler.Autoscaler, *[]autoscaler.Event) {
+	var events []autoscaler.Event
+	a, err := autoscaler.New(&autoscaler.Options{
+		Client:    client,
+		ClusterID: clusterID,
+		Policy:    policy,
+		Interval:  time.Minute,
+		Clock:     clock,
+		OnEvent: func(event autoscaler.Event) {
+			events = append(events, event)
+		},
+	})
+	require.Nil(t, err)
+	return a, &events
+}
+
+func TestCheckScalesToNextTier(t *testing.T) {
+	client := &fakeClient{storage: "10GiB", storageUsed: "8GiB"}
+	clock := newFakeClock()
+	a, events := newAutoscaler(t, client, clock, autoscaler.Policy{Threshold: 0.8, CoolDown: time.Hour})
+
+	event := a.Check(context.Background())
+	assert.Equal(t, autoscaler.EventTypeScaled, event.Type)
+	assert.Equal(t, clock.Now(), event.Time)
+	assert.Equal(t, 10*hpdbv3.GiB, event.Storage)
+	assert.Equal(t, 8*hpdbv3.GiB, event.StorageUsed)
+	assert.Equal(t, 0.8, event.Utilization)
+	assert.Equal(t, 16*hpdbv3.GiB, event.Target)
+	assert.Equal(t, "task-1", event.TaskID)
+	assert.Equal(t, "storage utilization 80% reached the threshold 80%: scaling storage from 10GiB to 16GiB", event.Reason)
+	assert.Equal(t, []string{"16GiB"}, client.scaledTo)
+	assert.Equal(t, []autoscaler.Event{event}, *events)
+
+	// The event is structured
+	b, err := json.Marshal(event)
+	require.Nil(t, err)
+	assert.Contains(t, string(b), `"type":"scaled"`)
+	assert.Contains(t, string(b), `"task_id":"task-1"`)
+
+	event = a.Check(context.Background())
+	assert.Equal(t, autoscaler.EventTypeNoAction, event.Type)
+	assert.Equal(t, 0.5, event.Utilization)
+}
+
+func TestCheckScalesOneTierAtATime(t *testing.T) {
+	client := &fakeClient{storage: "10GiB", storageUsed: "1GiB", databases: []int64{int64(10 * hpdbv3.GiB), int64(4 * hpdbv3.GiB)}}
+	clock := newFakeClock()
+	a, _ := newAutoscaler(t, client, clock, autoscaler.Policy{Threshold: 0.75})
+
+	// The databases use more than the reported used storage. The next tier stays above the threshold, but it is not
+	// skipped.
+	event := a.Check(context.Background())
+	assert.Equal(t, autoscaler.EventTypeScaled, event.Type)
+	assert.Equal(t, 14*hpdbv3.GiB, event.DatabaseSize)
+	assert.Equal(t, 1.4, event.Utilization)
+	assert.Equal(t, 16*hpdbv3.GiB, event.Target)
+
+	// The following check scales to the next tier again
+	clock.Advance(time.Minute)
+	event = a.Check(context.Background())
+	assert.Equal(t, autoscaler.EventTypeScaled, event.Type)
+	assert.Equal(t, 24*hpdbv3.GiB, event.Target)
+	assert.Equal(t, []string{"16GiB", "24GiB"}, client.scaledTo)
+}
+
+func TestCheckSkipsTiersWhichStayAboveThreshold(t *testing.T) {
+	client := &fakeClient{storage: "10GiB", storageUsed: "1GiB", databases: []int64{int64(10 * hpdbv3.GiB), int64(4 * hpdbv3.GiB)}}
+	a, _ := newAutoscaler(t, client, newFakeClock(), autoscaler.Policy{Threshold: 0.75, SkipTiers: true})
+
+	event := a.Check(context.Background())
+	assert.Equal(t, autoscaler.EventTypeScaled, event.Type)
+	assert.Equal(t, 1.4, event.Utilization)
+	assert.Equal(t, 24*hpdbv3.GiB, event.Target)
+}
+
+func TestCheckHonorsPolicy(t *testing.T) {
+	client := &fakeClient{storage: "10GiB", storageUsed: "9GiB"}
+	clock := newFakeClock()
+	a, events := newAutoscaler(t, client, clock, autoscaler.Policy{Threshold: 0.8, CoolDown: time.Hour, MaxStorage: 24 * hpdbv3.GiB})
+
+	assert.Equal(t, autoscaler.EventTypeScaled, a.Check(context.Background()).Type)
+
+	// The storage fills up again within the cool-down
+	client.storageUsed = "15GiB"
+	clock.Advance(30 * time.Minute)
+	event := a.Check(context.Background())
+	assert.Equal(t, autoscaler.EventTypeCoolDown, event.Type)
+	assert.Contains(t, event.Reason, "the storage was scaled 30m0s ago")
+
+	// The next tier is the maximum storage of the policy
+	clock.Advance(time.Hour)
+	event = a.Check(context.Background())
+	assert.Equal(t, autoscaler.EventTypeScaled, event.Type)
+	assert.Equal(t, 24*hpdbv3.GiB, event.Target)
+
+	// The cluster is at the maximum storage of the policy
+	client.storageUsed = "23GiB"
+	clock.Advance(2 * time.Hour)
+	event = a.Check(context.Background())
+	assert.Equal(t, autoscaler.EventTypeMaxReached, event.Type)
+	assert.Contains(t, event.Reason, "cannot grow beyond the maximum 24GiB")
+
+	assert.Equal(t, []string{"16GiB", "24GiB"}, client.scaledTo)
+	assert.Len(t, *events, 4)
+}
+
+func TestCheckWaitsForRunningTask(t *testing.T) {
+	client := &fakeClient{storage: "10GiB", storageUsed: "9GiB", runningTask: "task-7"}
+	a, _ := newAutoscaler(t, client, newFakeClock(), autoscaler.Policy{Threshold: 0.8})
+
+	event := a.Check(context.Background())
+	assert.Equal(t, autoscaler.EventTypeBusy, event.Type)
+	assert.Contains(t, event.Reason, "task task-7 is running")
+	assert.Empty(t, client.scaledTo)
+
+	client.runningTask = ""
+	assert.Equal(t, autoscaler.EventTypeScaled, a.Check(context.Background()).Type)
+}
+
+func TestCheckReportsErrors(t *testing.T) {
+	client := &fakeClient{err: errors.New("connection refused")}
+	a, _ := newAutoscaler(t, client, newFakeClock(), autoscaler.Policy{Threshold: 0.8})
+
+	event := a.Check(context.Background())
+	assert.Equal(t, autoscaler.EventTypeError, event.Type)
+	assert.ErrorContains(t, event.Err, "connection refused")
+	assert.Equal(t, event.Err.Error(), event.Reason)
+
+	client.err = nil
+	client.storage = "Storage"
+	assert.Equal(t, autoscaler.EventTypeError, a.Check(context.Background()).Type)
+}
+
+func TestRun(t *testing.T) {
+	client := &fakeClient{storage: "10GiB", storageUsed: "1GiB"}
+	clock := newFakeClock()
+	var mu sync.Mutex
+	var events []autoscaler.Event
+	a, err := autoscaler.New(&autoscaler.Options{
+		Client:    client,
+		ClusterID: clusterID,
+		Policy:    autoscaler.Policy{Threshold: 0.8},
+		Interval:  time.Minute,
+		Clock:     clock,
+		OnEvent: func(event autoscaler.Event) {
+			mu.Lock()
+			defer mu.Unlock()
+			events = append(events, event)
+		},
+	})
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- a.Run(ctx)
+	}()
+
+	// Run checks immediately and then at every interval
+	for i := 0; i < 3; i++ {
+		<-clock.waiting
+		clock.Advance(time.Minute)
+	}
+	<-clock.waiting
+	cancel()
+	assert.Equal(t, context.Canceled, <-done)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, events, 4)
+	for i, event := range events {
+		assert.Equal(t, autoscaler.EventTypeNoAction, event.Type)
+		assert.Equal(t, time.Duration(i)*time.Minute, event.Time.Sub(events[0].Time))
+	}
+}
+
+func TestNewWithInvalidOptions(t *testing.T) {
+	client := &fakeClient{}
+	for _, options := range []*autoscaler.Options{
+		nil,
+		{ClusterID: clusterID, Policy: autoscaler.Policy{Threshold: 0.8}},
+		{Client: client, Policy: autoscaler.Policy{Threshold: 0.8}},
+		{Client: client, ClusterID: clusterID},
+		{Client: client, ClusterID: clusterID, Policy: autoscaler.Policy{Threshold: 1.5}},
+		{Client: client, ClusterID: clusterID, Policy: autoscaler.Policy{Threshold: 0.8, CoolDown: -time.Hour}},
+	} {
+		a, err := autoscaler.New(options)
+		assert.NotNil(t, err)
+		assert.Nil(t, a)
+	}
+}
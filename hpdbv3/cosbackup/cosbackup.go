@@ -0,0 +1,359 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package cosbackup lists the backup files of a database cluster in IBM Cloud Object Storage (COS).
+//
+// A Client reads the bucket of a CosBackupConfig through the S3 API, with requests signed with its HMAC keys. The
+// objects of the bucket which are named after a backup, such as 2019-01-01-120000Z, are listed chronologically, and
+// each of them can be restored with the RestoreOptions returned by the client:
+//
+//	client, err := cosbackup.New(&cosbackup.Options{Config: config})
+//	...
+//	object, err := client.Latest(ctx, pointInTime)
+//	...
+//	taskID, _, err := hpdb.Restore(client.RestoreOptions(clusterID, object))
+//
+// The package only uses the standard library, and works with any S3-compatible server, such as MinIO.
+package cosbackup
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+)
+
+// ErrNotFound is matched by errors.Is when a bucket or a backup file does not exist.
+var ErrNotFound = errors.New("not found")
+
+// Options : The options of a Client.
+type Options struct {
+	// The backup configuration of the cluster (required). Its HMAC keys, endpoint and bucket instance CRN are used. The
+	// endpoint is accessed with HTTPS unless it includes a scheme, such as http://localhost:9000.
+	Config *hpdbv3.CosBackupConfig
+
+	// The name of the bucket. The last segment of the bucket instance CRN is used if this is empty.
+	Bucket string
+
+	// The prefix of the keys of the backup files in the bucket, such as backups/.
+	Prefix string
+
+	// The region of the signature of the requests. DefaultRegion is used if this is empty.
+	Region string
+
+	// The HTTP client which sends the requests. http.DefaultClient is used if this is nil.
+	HTTPClient *http.Client
+
+	// The source of time of the signatures. The real time is used if this is nil.
+	Now func() time.Time
+}
+
+// Client : Lists, stats and verifies the backup files in a COS bucket.
+type Client struct {
+	config     *hpdbv3.CosBackupConfig
+	endpoint   *url.URL
+	bucket     string
+	prefix     string
+	signer     Signer
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+// New returns a Client with the specified options.
+func New(options *Options) (*Client, error) {
+	if options == nil || options.Config == nil {
+		return nil, fmt.Errorf("the backup configuration is required")
+	}
+	config := options.Config
+	if config.CosHmacKeys == nil || core.StringNilMapper(config.CosHmacKeys.AccessKeyID) == "" || core.StringNilMapper(config.CosHmacKeys.SecretAccessKey) == "" {
+		return nil, fmt.Errorf("the HMAC keys of the backup configuration are required")
+	}
+	endpoint, err := parseEndpoint(core.StringNilMapper(config.CosEndpoint))
+	if err != nil {
+		return nil, err
+	}
+	bucket := options.Bucket
+	if bucket == "" {
+		if bucket, err = BucketFromCRN(core.StringNilMapper(config.BucketInstanceCrn)); err != nil {
+			return nil, err
+		}
+	}
+	client := &Client{
+		config:   config,
+		endpoint: endpoint,
+		bucket:   bucket,
+		prefix:   options.Prefix,
+		signer: Signer{
+			AccessKeyID:     *config.CosHmacKeys.AccessKeyID,
+			SecretAccessKey: *config.CosHmacKeys.SecretAccessKey,
+			Region:          options.Region,
+		},
+		httpClient: options.HTTPClient,
+		now:        options.Now,
+	}
+	if client.httpClient == nil {
+		client.httpClient = http.DefaultClient
+	}
+	if client.now == nil {
+		client.now = time.Now
+	}
+	return client, nil
+}
+
+// parseEndpoint returns the URL of a COS endpoint, such as s3.us-south.cloud-object-storage.appdomain.cloud.
+func parseEndpoint(endpoint string) (*url.URL, error) {
+	if endpoint == "" {
+		return nil, fmt.Errorf("the COS endpoint of the backup configuration is required")
+	}
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil, fmt.Errorf("invalid COS endpoint %q", endpoint)
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	return u, nil
+}
+
+// BucketFromCRN returns the name of the bucket of a bucket instance CRN, such as
+// crn:v1:bluemix:public:cloud-object-storage:global:a/{account_id}:{instance_id}:bucket:{bucket}.
+func BucketFromCRN(crn string) (string, error) {
+	segments := strings.Split(crn, ":")
+	if len(segments) != 10 || segments[0] != "crn" || segments[8] != "bucket" || segments[9] == "" {
+		return "", fmt.Errorf("invalid bucket instance CRN %q: it must end with :bucket:{bucket}", crn)
+	}
+	return segments[9], nil
+}
+
+// Object : A backup file in the bucket.
+type Object struct {
+	// The key of the object.
+	Key string `json:"key"`
+
+	// The backup file, whose name is set in RestoreOptions.BackupFile. It is the key without the prefix.
+	BackupFile hpdbv3.BackupFile `json:"backup_file"`
+
+	// The size of the object in bytes.
+	Size int64 `json:"size"`
+
+	// The time when the object was last modified.
+	LastModified time.Time `json:"last_modified"`
+
+	// The entity tag of the object.
+	ETag string `json:"etag,omitempty"`
+}
+
+// ResponseError : An error response of the S3 API.
+type ResponseError struct {
+	// The HTTP status code.
+	StatusCode int
+
+	// The error code, such as NoSuchBucket or NotFound.
+	Code string
+
+	// The error message.
+	Message string
+
+	// The bucket or the key of the request.
+	Resource string
+}
+
+// Error returns a message describing the error response.
+func (e *ResponseError) Error() string {
+	msg := fmt.Sprintf("COS request for %s failed with status %d", e.Resource, e.StatusCode)
+	if e.Code != "" {
+		msg += ": " + e.Code
+	}
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+// Is returns true for ErrNotFound if the bucket or the key does not exist.
+func (e *ResponseError) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
+// listBucketResult is the response of ListObjectsV2.
+type listBucketResult struct {
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+	Contents              []struct {
+		Key          string `xml:"Key"`
+		LastModified string `xml:"LastModified"`
+		ETag         string `xml:"ETag"`
+		Size         int64  `xml:"Size"`
+	} `xml:"Contents"`
+}
+
+// errorResponse is the body of an error response.
+type errorResponse struct {
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+// List returns the backup files in the bucket, from the oldest to the most recent. The objects whose key, without the
+// prefix, is not the name of a backup file are skipped.
+func (client *Client) List(ctx context.Context) ([]*Object, error) {
+	var objects []*Object
+	continuationToken := ""
+	for {
+		query := url.Values{"list-type": {"2"}}
+		if client.prefix != "" {
+			query.Set("prefix", client.prefix)
+		}
+		if continuationToken != "" {
+			query.Set("continuation-token", continuationToken)
+		}
+		res, err := client.do(ctx, http.MethodGet, "", query)
+		if err != nil {
+			return nil, err
+		}
+		var result listBucketResult
+		err = xml.NewDecoder(res.Body).Decode(&result)
+		res.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("error decoding the objects of bucket %s: %w", client.bucket, err)
+		}
+		for _, content := range result.Contents {
+			file, err := hpdbv3.ParseBackupFile(strings.TrimPrefix(content.Key, client.prefix))
+			if err != nil {
+				continue
+			}
+			object := &Object{Key: content.Key, BackupFile: file, Size: content.Size, ETag: strings.Trim(content.ETag, `"`)}
+			object.LastModified, _ = time.Parse(time.RFC3339Nano, content.LastModified)
+			objects = append(objects, object)
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			break
+		}
+		continuationToken = result.NextContinuationToken
+	}
+	sort.SliceStable(objects, func(i, j int) bool {
+		if !objects[i].BackupFile.CreatedAt.Equal(objects[j].BackupFile.CreatedAt) {
+			return objects[i].BackupFile.CreatedAt.Before(objects[j].BackupFile.CreatedAt)
+		}
+		return objects[i].Key < objects[j].Key
+	})
+	return objects, nil
+}
+
+// Latest returns the most recent backup file created at or before the specified time. It fails with an error matching
+// ErrNotFound if there is none.
+func (client *Client) Latest(ctx context.Context, before time.Time) (*Object, error) {
+	objects, err := client.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(objects) - 1; i >= 0; i-- {
+		if !objects[i].BackupFile.CreatedAt.After(before) {
+			return objects[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no backup file in bucket %s was created at or before %s: %w", client.bucket, before.UTC().Format(time.RFC3339), ErrNotFound)
+}
+
+// Stat returns the backup file with the specified name, such as 2019-01-01-120000Z. It fails with an error matching
+// ErrNotFound if the object does not exist.
+func (client *Client) Stat(ctx context.Context, name string) (*Object, error) {
+	file, err := hpdbv3.ParseBackupFile(name)
+	if err != nil {
+		return nil, err
+	}
+	key := client.prefix + name
+	res, err := client.do(ctx, http.MethodHead, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	res.Body.Close()
+	object := &Object{Key: key, BackupFile: file, Size: res.ContentLength, ETag: strings.Trim(res.Header.Get("ETag"), `"`)}
+	object.LastModified, _ = http.ParseTime(res.Header.Get("Last-Modified"))
+	return object, nil
+}
+
+// Verify checks that the backup file of a backup listed by ListBackups exists in the bucket and is not empty, and
+// returns it.
+func (client *Client) Verify(ctx context.Context, backup *hpdbv3.Backup) (*Object, error) {
+	file, err := backup.GetBackupFile()
+	if err != nil {
+		return nil, fmt.Errorf("backup %s: %w", core.StringNilMapper(backup.ID), err)
+	}
+	object, err := client.Stat(ctx, file.Name)
+	if err != nil {
+		return nil, fmt.Errorf("backup %s: %w", core.StringNilMapper(backup.ID), err)
+	}
+	if object.Size <= 0 {
+		return nil, fmt.Errorf("backup %s: the backup file %s is empty", core.StringNilMapper(backup.ID), object.Key)
+	}
+	return object, nil
+}
+
+// RestoreOptions returns the options which restore the cluster from the backup file, with the HMAC keys, endpoint and
+// bucket instance CRN of the backup configuration.
+func (client *Client) RestoreOptions(clusterID string, object *Object) *hpdbv3.RestoreOptions {
+	options := &hpdbv3.RestoreOptions{ClusterID: core.StringPtr(clusterID)}
+	options.SetSourceType(string(hpdbv3.RestoreSourceTypeCos))
+	options.SetCosHmacKeys(client.config.CosHmacKeys)
+	options.CosEndpoint = client.config.CosEndpoint
+	options.BucketInstanceCrn = client.config.BucketInstanceCrn
+	options.SetBackupFile(object.BackupFile.Name)
+	return options
+}
+
+// do sends a signed request for the bucket, or for a key of the bucket, and returns the response if it is successful.
+func (client *Client) do(ctx context.Context, method string, key string, query url.Values) (*http.Response, error) {
+	resource := client.bucket
+	if key != "" {
+		resource += "/" + key
+	}
+	u := *client.endpoint
+	u.Path += "/" + resource
+	u.RawQuery = query.Encode()
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	client.signer.Sign(req, client.now())
+	res, err := client.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return res, nil
+	}
+	defer res.Body.Close()
+	responseErr := &ResponseError{StatusCode: res.StatusCode, Resource: resource}
+	body, _ := io.ReadAll(io.LimitReader(res.Body, 64*1024))
+	var errResponse errorResponse
+	if xml.Unmarshal(body, &errResponse) == nil {
+		responseErr.Code, responseErr.Message = errResponse.Code, errResponse.Message
+	}
+	if responseErr.Code == "" && res.StatusCode == http.StatusNotFound {
+		responseErr.Code = "NotFound"
+	}
+	return nil, responseErr
+}
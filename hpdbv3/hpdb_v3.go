@@ -39,6 +39,15 @@ import (
 // API Version: 3
 type HpdbV3 struct {
 	Service *core.BaseService
+
+	// validateResources enables the validation of the resources requested by ScaleResources.
+	validateResources bool
+
+	// validateConfiguration enables the validation of the parameters to be updated by UpdateConfiguration.
+	validateConfiguration bool
+
+	// configurationJournal records the changes made by UpdateConfiguration, if it is not nil.
+	configurationJournal ConfigurationJournal
 }
 
 // DefaultServiceURL is the default URL to make service requests to.
@@ -47,9 +56,10 @@ const DefaultServiceURL = "https://dbaas900.hyperp-dbaas.cloud.ibm.com/api/v3/un
 // DefaultServiceName is the default key used to find external configuration information.
 const DefaultServiceName = "hpdb"
 
-const ParameterizedServiceURL = "https://dbaas900.hyperp-dbaas.cloud.ibm.com/api/v3/{account_id}"
+const ParameterizedServiceURL = "https://{endpoint}/api/v3/{account_id}"
 
 var defaultUrlVariables = map[string]string{
+	"endpoint": "dbaas900.hyperp-dbaas.cloud.ibm.com",
 	"account_id": "unknown",
 }
 
@@ -115,11 +125,6 @@ func NewHpdbV3(options *HpdbV3Options) (service *HpdbV3, err error) {
 	return
 }
 
-// GetServiceURLForRegion returns the service URL to be used for the specified region
-func GetServiceURLForRegion(region string) (string, error) {
-	return "", fmt.Errorf("service does not support regional URLs")
-}
-
 // Clone makes a copy of "hpdb" suitable for processing requests.
 func (hpdb *HpdbV3) Clone() *HpdbV3 {
 	if core.IsNil(hpdb) {
@@ -413,7 +418,9 @@ func (hpdb *HpdbV3) ListDatabasesWithContext(ctx context.Context, listDatabasesO
 }
 
 // ScaleResources : Scale resources
-// Scale resources in a specified cluster that is indicated by its ID.
+// Scale resources in a specified cluster that is indicated by its ID. If resource validation is enabled with
+// EnableResourceValidation, the resources are validated first, and a request that scales the storage also gets the
+// cluster to check that its storage does not shrink.
 func (hpdb *HpdbV3) ScaleResources(scaleResourcesOptions *ScaleResourcesOptions) (result *TaskID, response *core.DetailedResponse, err error) {
 	return hpdb.ScaleResourcesWithContext(context.Background(), scaleResourcesOptions)
 }
@@ -428,6 +435,12 @@ func (hpdb *HpdbV3) ScaleResourcesWithContext(ctx context.Context, scaleResource
 	if err != nil {
 		return
 	}
+	if hpdb.validateResources {
+		scaleResourcesOptions, response, err = hpdb.validateScaleResources(ctx, scaleResourcesOptions)
+		if err != nil {
+			return
+		}
+	}
 
 	pathParamsMap := map[string]string{
 		"cluster_id": *scaleResourcesOptions.ClusterID,
@@ -558,6 +571,22 @@ func (hpdb *HpdbV3) UpdateConfigurationWithContext(ctx context.Context, updateCo
 	if err != nil {
 		return
 	}
+	if hpdb.validateConfiguration {
+		response, err = hpdb.validateUpdateConfiguration(ctx, updateConfigurationOptions)
+		if err != nil {
+			return
+		}
+	}
+	if hpdb.configurationJournal != nil && updateConfigurationOptions.Configuration != nil {
+		var entry *ConfigurationJournalEntry
+		entry, response, err = hpdb.journalUpdateConfiguration(ctx, updateConfigurationOptions)
+		if err != nil {
+			return
+		}
+		defer func() {
+			err = hpdb.recordConfigurationChange(entry, result, err)
+		}()
+	}
 
 	pathParamsMap := map[string]string{
 		"cluster_id": *updateConfigurationOptions.ClusterID,
@@ -809,6 +838,10 @@ func (hpdb *HpdbV3) EnableCosBackupWithContext(ctx context.Context, enableCosBac
 	if err != nil {
 		return
 	}
+	err = ValidateBackupSchedule(enableCosBackupOptions.Schedule)
+	if err != nil {
+		return
+	}
 
 	pathParamsMap := map[string]string{
 		"cluster_id": *enableCosBackupOptions.ClusterID,
@@ -1068,6 +1101,12 @@ func (hpdb *HpdbV3) UpdateBackupConfigWithContext(ctx context.Context, updateBac
 	if err != nil {
 		return
 	}
+	if updateBackupConfigOptions.Cos != nil {
+		err = ValidateBackupSchedule(updateBackupConfigOptions.Cos.Schedule)
+		if err != nil {
+			return
+		}
+	}
 
 	pathParamsMap := map[string]string{
 		"cluster_id": *updateBackupConfigOptions.ClusterID,
@@ -1672,6 +1711,9 @@ type ConfigurationItem struct {
 
 	// Integer type parameter.
 	MaxPreparedTransactions *IntegerType `json:"max_prepared_transactions" validate:"required"`
+
+	// All the parameters by name, including the ones above, which take precedence. Use GetParameters to read them.
+	Parameters map[string]*Parameter `json:"-"`
 }
 
 // UnmarshalConfigurationItem unmarshals an instance of ConfigurationItem from the specified map of raw messages.
@@ -1697,6 +1739,10 @@ func UnmarshalConfigurationItem(m map[string]json.RawMessage, result interface{}
 	if err != nil {
 		return
 	}
+	err = unmarshalParameters(m, &obj.Parameters)
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -1717,6 +1763,9 @@ type Configurations struct {
 
 	// Value of max_prepared_transactions to be updated.
 	MaxPreparedTransactions *int64 `json:"max_prepared_transactions,omitempty"`
+
+	// Values of other parameters to be updated, by name. Use SetParameter to set any parameter.
+	Parameters map[string]ParameterValue `json:"-"`
 }
 
 // UnmarshalConfigurations unmarshals an instance of Configurations from the specified map of raw messages.
@@ -1742,6 +1791,10 @@ func UnmarshalConfigurations(m map[string]json.RawMessage, result interface{}) (
 	if err != nil {
 		return
 	}
+	err = unmarshalParameterValues(m, &obj.Parameters)
+	if err != nil {
+		return
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
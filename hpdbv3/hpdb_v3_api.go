@@ -0,0 +1,89 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@v2.53.5 --name HpdbV3API --output hpdbv3mock --outpkg hpdbv3mock --filename hpdb_v3_api.go
+
+// HpdbV3API : The operations of the HpdbV3 service.
+//
+// Code which depends on the service can accept an HpdbV3API instead of an *HpdbV3, so that it can be tested with the
+// mock in the hpdbv3mock package.
+type HpdbV3API interface {
+	// GetClusterWithContext : Get database cluster details
+	GetClusterWithContext(ctx context.Context, getClusterOptions *GetClusterOptions) (result *Cluster, response *core.DetailedResponse, err error)
+
+	// ListUsersWithContext : List database users
+	ListUsersWithContext(ctx context.Context, listUsersOptions *ListUsersOptions) (result *Users, response *core.DetailedResponse, err error)
+
+	// GetUserWithContext : Get database user details
+	GetUserWithContext(ctx context.Context, getUserOptions *GetUserOptions) (result *UserDetails, response *core.DetailedResponse, err error)
+
+	// ListDatabasesWithContext : List databases
+	ListDatabasesWithContext(ctx context.Context, listDatabasesOptions *ListDatabasesOptions) (result *Databases, response *core.DetailedResponse, err error)
+
+	// ScaleResourcesWithContext : Scale resources
+	ScaleResourcesWithContext(ctx context.Context, scaleResourcesOptions *ScaleResourcesOptions) (result *TaskID, response *core.DetailedResponse, err error)
+
+	// GetConfigurationWithContext : Get configuration
+	GetConfigurationWithContext(ctx context.Context, getConfigurationOptions *GetConfigurationOptions) (result *Configuration, response *core.DetailedResponse, err error)
+
+	// UpdateConfigurationWithContext : Update configuration
+	UpdateConfigurationWithContext(ctx context.Context, updateConfigurationOptions *UpdateConfigurationOptions) (result *TaskID, response *core.DetailedResponse, err error)
+
+	// ListTasksWithContext : List tasks
+	ListTasksWithContext(ctx context.Context, listTasksOptions *ListTasksOptions) (result *Tasks, response *core.DetailedResponse, err error)
+
+	// GetTaskWithContext : Show task
+	GetTaskWithContext(ctx context.Context, getTaskOptions *GetTaskOptions) (result *Task, response *core.DetailedResponse, err error)
+
+	// ListBackupsWithContext : List backups
+	ListBackupsWithContext(ctx context.Context, listBackupsOptions *ListBackupsOptions) (result *ListBackupsResponse, response *core.DetailedResponse, err error)
+
+	// EnableCosBackupWithContext : Enable backup to COS
+	EnableCosBackupWithContext(ctx context.Context, enableCosBackupOptions *EnableCosBackupOptions) (result *TaskID, response *core.DetailedResponse, err error)
+
+	// DisableCosBackupWithContext : Disable backup to COS
+	DisableCosBackupWithContext(ctx context.Context, disableCosBackupOptions *DisableCosBackupOptions) (result *TaskID, response *core.DetailedResponse, err error)
+
+	// GetCosBackupConfigWithContext : Get backup configuration (Deprecated)
+	GetCosBackupConfigWithContext(ctx context.Context, getCosBackupConfigOptions *GetCosBackupConfigOptions) (result *GetCosBackupConfigResponse, response *core.DetailedResponse, err error)
+
+	// GetBackupConfigWithContext : Get backup configuration
+	GetBackupConfigWithContext(ctx context.Context, getBackupConfigOptions *GetBackupConfigOptions) (result *GetBackupConfigResponse, response *core.DetailedResponse, err error)
+
+	// UpdateBackupConfigWithContext : Update backup configuration
+	UpdateBackupConfigWithContext(ctx context.Context, updateBackupConfigOptions *UpdateBackupConfigOptions) (result *TaskID, response *core.DetailedResponse, err error)
+
+	// RestoreWithContext : Restore from backup
+	RestoreWithContext(ctx context.Context, restoreOptions *RestoreOptions) (result *TaskID, response *core.DetailedResponse, err error)
+
+	// ListNodeLogsWithContext : List database log files of a node
+	ListNodeLogsWithContext(ctx context.Context, listNodeLogsOptions *ListNodeLogsOptions) (result *LogList, response *core.DetailedResponse, err error)
+
+	// GetLogWithContext : Get log details
+	GetLogWithContext(ctx context.Context, getLogOptions *GetLogOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+}
+
+// HpdbV3 implements HpdbV3API
+var _ HpdbV3API = (*HpdbV3)(nil)
@@ -0,0 +1,118 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3
+
+import (
+	"fmt"
+	"time"
+)
+
+// BackupFileLayout is the layout of the names of the backup files in COS: the time when the backup was created in UTC,
+// formatted as yyyy-mm-dd-hhmmssZ, such as 2019-01-01-120000Z.
+const BackupFileLayout = "2006-01-02-150405Z"
+
+// BackupFile : A backup file in COS, which is restored by setting its name in RestoreOptions.BackupFile.
+type BackupFile struct {
+	// The name of the backup file.
+	Name string `json:"name"`
+
+	// The time when the backup was created, in UTC, to the second.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewBackupFile returns the backup file of a backup created at the specified time. The time is truncated to the second.
+func NewBackupFile(createdAt time.Time) BackupFile {
+	createdAt = createdAt.UTC().Truncate(time.Second)
+	return BackupFile{Name: createdAt.Format(BackupFileLayout), CreatedAt: createdAt}
+}
+
+// ParseBackupFile parses the name of a backup file, such as 2019-01-01-120000Z. Names which are not exactly in the
+// BackupFileLayout, such as 2019-1-1-120000Z or 2019-01-01-120000, are rejected.
+func ParseBackupFile(name string) (BackupFile, error) {
+	createdAt, err := time.Parse(BackupFileLayout, name)
+	if err != nil || createdAt.Format(BackupFileLayout) != name {
+		return BackupFile{}, fmt.Errorf("invalid backup file name %q: the name must be the UTC time of the backup formatted as yyyy-mm-dd-hhmmssZ", name)
+	}
+	return BackupFile{Name: name, CreatedAt: createdAt}, nil
+}
+
+// String returns the name of the backup file.
+func (file BackupFile) String() string {
+	return file.Name
+}
+
+// Matches returns true if the backup file and the backup were created at the same second.
+func (file BackupFile) Matches(backup *Backup) bool {
+	createdAt, err := backup.GetCreatedAt()
+	return err == nil && !createdAt.IsZero() && createdAt.Truncate(time.Second).Equal(file.CreatedAt)
+}
+
+// GetBackupFile returns the backup file of the backup in COS, named after the time when it was created.
+func (backup *Backup) GetBackupFile() (BackupFile, error) {
+	createdAt, err := backup.GetCreatedAt()
+	if err != nil {
+		return BackupFile{}, err
+	}
+	if createdAt.IsZero() {
+		return BackupFile{}, fmt.Errorf("the creation time of the backup is not set")
+	}
+	return NewBackupFile(createdAt), nil
+}
+
+// BackupFiles : Backup files which sort chronologically with sort.Sort, from the oldest to the most recent. The files
+// created at the same second are sorted by name.
+type BackupFiles []BackupFile
+
+// ParseBackupFiles parses the names of backup files. It fails on the first name which is not valid.
+func ParseBackupFiles(names []string) (BackupFiles, error) {
+	files := make(BackupFiles, 0, len(names))
+	for _, name := range names {
+		file, err := ParseBackupFile(name)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+func (files BackupFiles) Len() int {
+	return len(files)
+}
+
+func (files BackupFiles) Less(i, j int) bool {
+	if !files[i].CreatedAt.Equal(files[j].CreatedAt) {
+		return files[i].CreatedAt.Before(files[j].CreatedAt)
+	}
+	return files[i].Name < files[j].Name
+}
+
+func (files BackupFiles) Swap(i, j int) {
+	files[i], files[j] = files[j], files[i]
+}
+
+// Latest returns the most recent backup file created at or before the specified time, and false if there is none. The
+// files do not need to be sorted.
+func (files BackupFiles) Latest(before time.Time) (latest BackupFile, found bool) {
+	for _, file := range files {
+		if file.CreatedAt.After(before) || (found && !latest.CreatedAt.Before(file.CreatedAt)) {
+			continue
+		}
+		latest, found = file, true
+	}
+	return
+}
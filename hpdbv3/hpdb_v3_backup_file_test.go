@@ -0,0 +1,103 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3_test
+
+import (
+	"sort"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`HpdbV3 backup files`, func() {
+	Describe(`ParseBackupFile(name string)`, func() {
+		It(`Invoke ParseBackupFile successfully`, func() {
+			file, err := hpdbv3.ParseBackupFile("2026-03-01-163015Z")
+			Expect(err).To(BeNil())
+			Expect(file.Name).To(Equal("2026-03-01-163015Z"))
+			Expect(file.CreatedAt).To(Equal(time.Date(2026, time.March, 1, 16, 30, 15, 0, time.UTC)))
+			Expect(file.String()).To(Equal("2026-03-01-163015Z"))
+		})
+		It(`Invoke ParseBackupFile with error`, func() {
+			for _, name := range []string{"", "2026-03-01-163015", "2026-3-1-163015Z", "2026-03-01-16301Z", "2026-03-01T16:30:15Z", "2026-02-30-163015Z", "backups/2026-03-01-163015Z"} {
+				_, err := hpdbv3.ParseBackupFile(name)
+				Expect(err).ToNot(BeNil(), name)
+			}
+		})
+	})
+	Describe(`NewBackupFile(createdAt time.Time)`, func() {
+		It(`Invoke NewBackupFile successfully`, func() {
+			file := hpdbv3.NewBackupFile(time.Date(2026, time.March, 1, 17, 30, 15, 999000000, time.FixedZone("CET", 3600)))
+			Expect(file.Name).To(Equal("2026-03-01-163015Z"))
+			Expect(file.CreatedAt).To(Equal(time.Date(2026, time.March, 1, 16, 30, 15, 0, time.UTC)))
+
+			parsed, err := hpdbv3.ParseBackupFile(file.Name)
+			Expect(err).To(BeNil())
+			Expect(parsed).To(Equal(file))
+		})
+	})
+	Describe(`GetBackupFile()`, func() {
+		It(`Invoke GetBackupFile successfully`, func() {
+			backup := &hpdbv3.Backup{ID: core.StringPtr("backup-1"), CreatedAt: core.StringPtr("2026-03-01T16:30:15.250Z")}
+			file, err := backup.GetBackupFile()
+			Expect(err).To(BeNil())
+			Expect(file.Name).To(Equal("2026-03-01-163015Z"))
+			Expect(file.Matches(backup)).To(BeTrue())
+			Expect(file.Matches(&hpdbv3.Backup{CreatedAt: core.StringPtr("2026-03-01T16:30:16.000Z")})).To(BeFalse())
+			Expect(file.Matches(&hpdbv3.Backup{})).To(BeFalse())
+		})
+		It(`Invoke GetBackupFile with error`, func() {
+			_, err := (&hpdbv3.Backup{}).GetBackupFile()
+			Expect(err).ToNot(BeNil())
+			_, err = (&hpdbv3.Backup{CreatedAt: core.StringPtr("invalid")}).GetBackupFile()
+			Expect(err).ToNot(BeNil())
+		})
+	})
+	Describe(`BackupFiles`, func() {
+		It(`Invoke sort.Sort successfully`, func() {
+			files, err := hpdbv3.ParseBackupFiles([]string{"2026-03-02-080000Z", "2025-12-31-235959Z", "2026-03-01-163015Z", "2026-03-01-080000Z"})
+			Expect(err).To(BeNil())
+			sort.Sort(files)
+			var names []string
+			for _, file := range files {
+				names = append(names, file.Name)
+			}
+			Expect(names).To(Equal([]string{"2025-12-31-235959Z", "2026-03-01-080000Z", "2026-03-01-163015Z", "2026-03-02-080000Z"}))
+		})
+		It(`Invoke Latest successfully`, func() {
+			files, err := hpdbv3.ParseBackupFiles([]string{"2026-03-02-080000Z", "2026-03-01-080000Z", "2026-03-01-163015Z"})
+			Expect(err).To(BeNil())
+			latest, found := files.Latest(time.Date(2026, time.March, 1, 20, 0, 0, 0, time.UTC))
+			Expect(found).To(BeTrue())
+			Expect(latest.Name).To(Equal("2026-03-01-163015Z"))
+
+			latest, found = files.Latest(time.Date(2026, time.March, 1, 16, 30, 15, 0, time.UTC))
+			Expect(found).To(BeTrue())
+			Expect(latest.Name).To(Equal("2026-03-01-163015Z"))
+
+			_, found = files.Latest(time.Date(2026, time.March, 1, 7, 0, 0, 0, time.UTC))
+			Expect(found).To(BeFalse())
+		})
+		It(`Invoke ParseBackupFiles with error`, func() {
+			_, err := hpdbv3.ParseBackupFiles([]string{"2026-03-01-080000Z", "latest"})
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
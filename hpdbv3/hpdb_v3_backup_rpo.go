@@ -0,0 +1,247 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// DefaultRPOGracePeriod is the time a backup may be late before the recovery point objective is violated.
+const DefaultRPOGracePeriod = 30 * time.Minute
+
+// RPOStatus : The compliance of a cluster with its recovery point objective.
+type RPOStatus string
+
+// The statuses of an RPOReport.
+const (
+	// The latest backup is more recent than the objective.
+	RPOStatusCompliant RPOStatus = "compliant"
+
+	// The latest backup is older than the objective.
+	RPOStatusViolated RPOStatus = "violated"
+
+	// The cluster has no backup.
+	RPOStatusNoBackup RPOStatus = "no_backup"
+
+	// Backup to COS is not enabled on the cluster.
+	RPOStatusBackupDisabled RPOStatus = "backup_disabled"
+
+	// The backup schedule has no interval, such as a schedule of a type other than frequency, so the objective is
+	// unknown.
+	RPOStatusUnknownSchedule RPOStatus = "unknown_schedule"
+)
+
+// RPOChecker : Checks whether the backups of a cluster meet its recovery point objective (RPO): the latest backup must
+// be more recent than the interval of the backup schedule plus a grace period.
+type RPOChecker struct {
+	hpdb        *HpdbV3
+	clusterID   string
+	gracePeriod time.Duration
+	now         func() time.Time
+	headers     map[string]string
+}
+
+// NewRPOChecker returns an RPOChecker for the cluster, with the DefaultRPOGracePeriod.
+func (hpdb *HpdbV3) NewRPOChecker(clusterID string) *RPOChecker {
+	return &RPOChecker{
+		hpdb:        hpdb,
+		clusterID:   clusterID,
+		gracePeriod: DefaultRPOGracePeriod,
+		now:         time.Now,
+	}
+}
+
+// SetGracePeriod : Allow user to set the time a backup may be late
+func (checker *RPOChecker) SetGracePeriod(gracePeriod time.Duration) *RPOChecker {
+	checker.gracePeriod = gracePeriod
+	return checker
+}
+
+// SetClock : Allow user to set the function which returns the current time
+func (checker *RPOChecker) SetClock(now func() time.Time) *RPOChecker {
+	checker.now = now
+	return checker
+}
+
+// SetHeaders : Allow user to set the headers of the API requests
+func (checker *RPOChecker) SetHeaders(headers map[string]string) *RPOChecker {
+	checker.headers = headers
+	return checker
+}
+
+// RPOReport : The compliance of a cluster with its recovery point objective, as checked by RPOChecker.Check. It is
+// encoded in JSON with the durations formatted as strings, such as 8h30m0s, to be exported or alerted on.
+type RPOReport struct {
+	// The ID of the cluster.
+	ClusterID string `json:"cluster_id"`
+
+	// The time of the check.
+	CheckedAt time.Time `json:"checked_at"`
+
+	// The compliance of the cluster.
+	Status RPOStatus `json:"status"`
+
+	// Why the cluster is compliant or not.
+	Reason string `json:"reason"`
+
+	// Whether backup to COS is enabled on the cluster.
+	CosBackupEnabled bool `json:"cos_backup_enabled"`
+
+	// The interval of the backup schedule. It is empty if backup to COS is not enabled or if the schedule has no
+	// interval.
+	Interval BackupInterval `json:"interval,omitempty"`
+
+	// The time a backup may be late.
+	GracePeriod time.Duration `json:"grace_period"`
+
+	// The maximum age of the latest backup: the interval plus the grace period. It is 0 if the interval is empty.
+	Objective time.Duration `json:"objective"`
+
+	// The ID of the latest backup. It is empty if the cluster has no backup.
+	LatestBackupID string `json:"latest_backup_id,omitempty"`
+
+	// The time when the latest backup was created.
+	LatestBackupAt *time.Time `json:"latest_backup_at,omitempty"`
+
+	// The age of the latest backup at the time of the check.
+	LatestBackupAge time.Duration `json:"latest_backup_age,omitempty"`
+
+	// The time when the next backup is expected.
+	NextBackupAt *time.Time `json:"next_backup_at,omitempty"`
+}
+
+// IsCompliant returns true if the cluster meets its recovery point objective.
+func (report *RPOReport) IsCompliant() bool {
+	return report.Status == RPOStatusCompliant
+}
+
+// MarshalJSON encodes the report, with the durations formatted as strings.
+func (report RPOReport) MarshalJSON() ([]byte, error) {
+	type rpoReport RPOReport
+	encoded := struct {
+		*rpoReport
+		GracePeriod     string `json:"grace_period"`
+		Objective       string `json:"objective"`
+		LatestBackupAge string `json:"latest_backup_age,omitempty"`
+	}{
+		rpoReport:   (*rpoReport)(&report),
+		GracePeriod: report.GracePeriod.String(),
+		Objective:   report.Objective.String(),
+	}
+	if report.LatestBackupAt != nil {
+		encoded.LatestBackupAge = report.LatestBackupAge.String()
+	}
+	return json.Marshal(encoded)
+}
+
+// Check gets the cluster, its backup configuration and its backups, and reports whether the latest backup meets the
+// recovery point objective. A cluster on which backup to COS is not enabled is not compliant, and neither is a cluster
+// whose backup schedule has no interval, such as a schedule of a type other than frequency: its report has the
+// RPOStatusUnknownSchedule status, and no interval, objective or next backup time. An error is returned only if the
+// service cannot be queried.
+func (checker *RPOChecker) Check(ctx context.Context) (report *RPOReport, err error) {
+	if checker.clusterID == "" {
+		return nil, fmt.Errorf("clusterID cannot be empty")
+	}
+	getClusterOptions := checker.hpdb.NewGetClusterOptions(checker.clusterID)
+	getClusterOptions.SetHeaders(checker.headers)
+	cluster, _, err := checker.hpdb.GetClusterWithContext(ctx, getClusterOptions)
+	if err != nil {
+		return
+	}
+	report = &RPOReport{
+		ClusterID:        checker.clusterID,
+		CheckedAt:        checker.now().UTC(),
+		CosBackupEnabled: cluster.IsCosBackupEnabled != nil && *cluster.IsCosBackupEnabled,
+		GracePeriod:      checker.gracePeriod,
+	}
+
+	var schedule *BackupSchedule
+	var scheduleErr error
+	if report.CosBackupEnabled {
+		getBackupConfigOptions := checker.hpdb.NewGetBackupConfigOptions(checker.clusterID)
+		getBackupConfigOptions.SetHeaders(checker.headers)
+		config, _, err := checker.hpdb.GetBackupConfigWithContext(ctx, getBackupConfigOptions)
+		if err != nil {
+			return nil, err
+		}
+		if config.Cos != nil {
+			schedule = config.Cos.Schedule
+		}
+		if report.Interval, scheduleErr = schedule.GetInterval(); scheduleErr == nil {
+			report.Objective = report.Interval.Duration() + checker.gracePeriod
+		}
+	}
+
+	listBackupsOptions := checker.hpdb.NewListBackupsOptions(checker.clusterID)
+	listBackupsOptions.SetHeaders(checker.headers)
+	backups, _, err := checker.hpdb.ListBackupsWithContext(ctx, listBackupsOptions)
+	if err != nil {
+		return nil, err
+	}
+	var latest *Backup
+	var latestAt time.Time
+	for i := range backups.Backups {
+		createdAt, err := backups.Backups[i].GetCreatedAt()
+		if err == nil && createdAt.After(latestAt) {
+			latest, latestAt = &backups.Backups[i], createdAt
+		}
+	}
+	if latest != nil {
+		report.LatestBackupAt = &latestAt
+		report.LatestBackupAge = report.CheckedAt.Sub(latestAt)
+		if latest.ID != nil {
+			report.LatestBackupID = *latest.ID
+		}
+	}
+
+	switch {
+	case !report.CosBackupEnabled:
+		report.Status = RPOStatusBackupDisabled
+		report.Reason = "backup to COS is not enabled"
+	case scheduleErr != nil:
+		report.Status = RPOStatusUnknownSchedule
+		report.Reason = fmt.Sprintf("the recovery point objective cannot be checked: %s", scheduleErr)
+	case latest == nil:
+		report.Status = RPOStatusNoBackup
+		report.Reason = "the cluster has no backup"
+	case report.LatestBackupAge > report.Objective:
+		report.Status = RPOStatusViolated
+		report.Reason = fmt.Sprintf("the latest backup is %s old, older than the objective of %s", report.LatestBackupAge, report.Objective)
+	default:
+		report.Status = RPOStatusCompliant
+		report.Reason = fmt.Sprintf("the latest backup is %s old, within the objective of %s", report.LatestBackupAge, report.Objective)
+	}
+	if report.CosBackupEnabled && scheduleErr == nil && latest != nil {
+		next, err := NextBackupTime(schedule, backups.Backups)
+		if err != nil {
+			return nil, err
+		}
+		report.NextBackupAt = &next
+	}
+	return report, nil
+}
+
+// CheckRPO : Check the recovery point objective of a cluster
+// Check whether the latest backup of a cluster that is indicated by its ID is more recent than the interval of its
+// backup schedule plus the DefaultRPOGracePeriod. Use NewRPOChecker to change the grace period.
+func (hpdb *HpdbV3) CheckRPO(ctx context.Context, clusterID string) (*RPOReport, error) {
+	return hpdb.NewRPOChecker(clusterID).Check(ctx)
+}
@@ -0,0 +1,148 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3/hpdbv3test"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`HpdbV3 RPO checker`, func() {
+	var server *hpdbv3test.Server
+	var hpdbService *hpdbv3.HpdbV3
+	clusterID := "9cebab98-afeb-4886-9a29-8e741716e7ff"
+
+	BeforeEach(func() {
+		server = hpdbv3test.NewServer()
+		server.SetTaskDuration(0)
+		server.AddCluster(hpdbv3test.NewCluster(clusterID))
+		var err error
+		hpdbService, err = server.NewClient()
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	enableCosBackup := func(interval hpdbv3.BackupInterval) {
+		options := hpdbService.NewEnableCosBackupOptions(clusterID).
+			SetCosHmacKeys(&hpdbv3.CosHmacKeys{AccessKeyID: core.StringPtr("access-key-id"), SecretAccessKey: core.StringPtr("secret-access-key")}).
+			SetCosEndpoint("s3.us-south.cloud-object-storage.appdomain.cloud").
+			SetBucketInstanceCrn("crn:v1:bluemix:public:cloud-object-storage:global:a/23a24a3e3fe7a115473f07be1c44bdb5:bucket:backups").
+			SetSchedule(hpdbv3.NewBackupSchedule(interval))
+		_, _, err := hpdbService.EnableCosBackupAndWait(context.Background(), options, &hpdbv3.WaitForTaskOptions{Interval: time.Millisecond})
+		Expect(err).To(BeNil())
+	}
+	check := func(checker *hpdbv3.RPOChecker) *hpdbv3.RPOReport {
+		report, err := checker.SetClock(server.Now).Check(context.Background())
+		Expect(err).To(BeNil())
+		return report
+	}
+
+	Describe(`Check(ctx context.Context)`, func() {
+		It(`Invoke Check successfully`, func() {
+			enableCosBackup(hpdbv3.BackupInterval1Hour)
+			backupAt := server.Now()
+			server.AddBackup(clusterID, hpdbv3.Backup{ID: core.StringPtr("backup-1")})
+			server.Advance(80 * time.Minute)
+
+			report := check(hpdbService.NewRPOChecker(clusterID))
+			Expect(report.IsCompliant()).To(BeTrue())
+			Expect(report.Status).To(Equal(hpdbv3.RPOStatusCompliant))
+			Expect(report.Reason).To(Equal("the latest backup is 1h20m0s old, within the objective of 1h30m0s"))
+			Expect(report.CosBackupEnabled).To(BeTrue())
+			Expect(report.Interval).To(Equal(hpdbv3.BackupInterval1Hour))
+			Expect(report.GracePeriod).To(Equal(hpdbv3.DefaultRPOGracePeriod))
+			Expect(report.Objective).To(Equal(90 * time.Minute))
+			Expect(report.LatestBackupID).To(Equal("backup-1"))
+			Expect(report.LatestBackupAt.Equal(backupAt.Truncate(time.Millisecond))).To(BeTrue())
+			Expect(report.NextBackupAt.Equal(report.LatestBackupAt.Add(time.Hour))).To(BeTrue())
+
+			data, err := json.Marshal(report)
+			Expect(err).To(BeNil())
+			var encoded map[string]interface{}
+			Expect(json.Unmarshal(data, &encoded)).To(Succeed())
+			Expect(encoded["status"]).To(Equal("compliant"))
+			Expect(encoded["interval"]).To(Equal("1h"))
+			Expect(encoded["objective"]).To(Equal("1h30m0s"))
+			Expect(encoded["latest_backup_age"]).To(Equal("1h20m0s"))
+			Expect(encoded["latest_backup_id"]).To(Equal("backup-1"))
+		})
+		It(`Invoke Check successfully with a late backup`, func() {
+			enableCosBackup(hpdbv3.BackupInterval1Hour)
+			server.AddBackup(clusterID, hpdbv3.Backup{ID: core.StringPtr("backup-1")})
+			server.Advance(80 * time.Minute)
+
+			report := check(hpdbService.NewRPOChecker(clusterID).SetGracePeriod(10 * time.Minute))
+			Expect(report.IsCompliant()).To(BeFalse())
+			Expect(report.Status).To(Equal(hpdbv3.RPOStatusViolated))
+			Expect(report.Reason).To(Equal("the latest backup is 1h20m0s old, older than the objective of 1h10m0s"))
+
+			// A new backup brings the cluster back into compliance
+			server.AddBackup(clusterID, hpdbv3.Backup{ID: core.StringPtr("backup-2")})
+			report = check(hpdbService.NewRPOChecker(clusterID).SetGracePeriod(10 * time.Minute))
+			Expect(report.Status).To(Equal(hpdbv3.RPOStatusCompliant))
+			Expect(report.LatestBackupID).To(Equal("backup-2"))
+		})
+		It(`Invoke Check successfully without backups`, func() {
+			report := check(hpdbService.NewRPOChecker(clusterID))
+			Expect(report.Status).To(Equal(hpdbv3.RPOStatusBackupDisabled))
+			Expect(report.CosBackupEnabled).To(BeFalse())
+			Expect(report.Interval).To(BeEmpty())
+
+			enableCosBackup(hpdbv3.BackupInterval1Day)
+			report = check(hpdbService.NewRPOChecker(clusterID))
+			Expect(report.Status).To(Equal(hpdbv3.RPOStatusNoBackup))
+			Expect(report.LatestBackupAt).To(BeNil())
+			Expect(report.NextBackupAt).To(BeNil())
+			Expect(report.Objective).To(Equal(24*time.Hour + hpdbv3.DefaultRPOGracePeriod))
+		})
+		It(`Invoke Check successfully with a schedule which has no interval`, func() {
+			options := hpdbService.NewEnableCosBackupOptions(clusterID).
+				SetCosHmacKeys(&hpdbv3.CosHmacKeys{AccessKeyID: core.StringPtr("access-key-id"), SecretAccessKey: core.StringPtr("secret-access-key")}).
+				SetCosEndpoint("s3.us-south.cloud-object-storage.appdomain.cloud").
+				SetBucketInstanceCrn("crn:v1:bluemix:public:cloud-object-storage:global:a/23a24a3e3fe7a115473f07be1c44bdb5:bucket:backups").
+				SetSchedule(&hpdbv3.BackupSchedule{Type: core.StringPtr("cron"), Value: core.StringPtr("0 3 * * *")})
+			_, _, err := hpdbService.EnableCosBackupAndWait(context.Background(), options, &hpdbv3.WaitForTaskOptions{Interval: time.Millisecond})
+			Expect(err).To(BeNil())
+			server.AddBackup(clusterID, hpdbv3.Backup{ID: core.StringPtr("backup-1")})
+
+			report := check(hpdbService.NewRPOChecker(clusterID))
+			Expect(report.IsCompliant()).To(BeFalse())
+			Expect(report.Status).To(Equal(hpdbv3.RPOStatusUnknownSchedule))
+			Expect(report.Reason).To(Equal("the recovery point objective cannot be checked: the backup schedule of type cron has no interval"))
+			Expect(report.CosBackupEnabled).To(BeTrue())
+			Expect(report.Interval).To(BeEmpty())
+			Expect(report.Objective).To(BeZero())
+			Expect(report.LatestBackupID).To(Equal("backup-1"))
+			Expect(report.NextBackupAt).To(BeNil())
+		})
+		It(`Invoke Check with error`, func() {
+			_, err := hpdbService.CheckRPO(context.Background(), "no-such-cluster")
+			Expect(err).ToNot(BeNil())
+			_, err = hpdbService.CheckRPO(context.Background(), "")
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
@@ -0,0 +1,158 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// BackupInterval : The interval between two backups to COS, which is the value of a BackupSchedule of the frequency
+// type.
+type BackupInterval string
+
+// The intervals that backups to COS can be scheduled at.
+const (
+	BackupInterval1Hour  BackupInterval = "1h"
+	BackupInterval2Hours BackupInterval = "2h"
+	BackupInterval4Hours BackupInterval = "4h"
+	BackupInterval8Hours BackupInterval = "8h"
+	BackupInterval1Day   BackupInterval = "1d"
+	BackupInterval2Days  BackupInterval = "2d"
+	BackupInterval1Week  BackupInterval = "1w"
+)
+
+// DefaultBackupInterval is the interval of the backups to COS when no schedule is set.
+const DefaultBackupInterval = BackupInterval8Hours
+
+// BackupIntervals are the intervals that backups to COS can be scheduled at, from the shortest to the longest.
+var BackupIntervals = []BackupInterval{
+	BackupInterval1Hour,
+	BackupInterval2Hours,
+	BackupInterval4Hours,
+	BackupInterval8Hours,
+	BackupInterval1Day,
+	BackupInterval2Days,
+	BackupInterval1Week,
+}
+
+var backupIntervalDurations = map[BackupInterval]time.Duration{
+	BackupInterval1Hour:  time.Hour,
+	BackupInterval2Hours: 2 * time.Hour,
+	BackupInterval4Hours: 4 * time.Hour,
+	BackupInterval8Hours: 8 * time.Hour,
+	BackupInterval1Day:   24 * time.Hour,
+	BackupInterval2Days:  48 * time.Hour,
+	BackupInterval1Week:  7 * 24 * time.Hour,
+}
+
+// ParseBackupInterval parses an interval such as 8h or 1D. The unit is case-insensitive: h means hour, d means day and
+// w means week.
+func ParseBackupInterval(value string) (BackupInterval, error) {
+	interval := BackupInterval(strings.ToLower(strings.TrimSpace(value)))
+	if !interval.IsValid() {
+		return "", fmt.Errorf("invalid backup interval %q: valid intervals are %s", value, joinBackupIntervals())
+	}
+	return interval, nil
+}
+
+// BackupIntervalFromDuration returns the interval of the specified duration, such as 1d for 24 hours.
+func BackupIntervalFromDuration(d time.Duration) (BackupInterval, error) {
+	for _, interval := range BackupIntervals {
+		if backupIntervalDurations[interval] == d {
+			return interval, nil
+		}
+	}
+	return "", fmt.Errorf("invalid backup interval %s: valid intervals are %s", d, joinBackupIntervals())
+}
+
+// IsValid returns true if the interval is one of the BackupInterval constants.
+func (interval BackupInterval) IsValid() bool {
+	_, ok := backupIntervalDurations[interval]
+	return ok
+}
+
+// Duration returns the interval as a duration, or 0 if it is not valid.
+func (interval BackupInterval) Duration() time.Duration {
+	return backupIntervalDurations[interval]
+}
+
+// NewBackupSchedule returns a schedule of the frequency type with the specified interval.
+func NewBackupSchedule(interval BackupInterval) *BackupSchedule {
+	return &BackupSchedule{
+		Type:  core.StringPtr(string(BackupScheduleTypeFrequency)),
+		Value: core.StringPtr(string(interval)),
+	}
+}
+
+// GetInterval returns the interval of a schedule of the frequency type, or DefaultBackupInterval if the schedule or its
+// value is not set.
+func (schedule *BackupSchedule) GetInterval() (BackupInterval, error) {
+	if schedule == nil || schedule.Value == nil {
+		return DefaultBackupInterval, nil
+	}
+	if scheduleType := schedule.GetType(); scheduleType != "" && scheduleType != BackupScheduleTypeFrequency {
+		return "", fmt.Errorf("the backup schedule of type %s has no interval", scheduleType)
+	}
+	return ParseBackupInterval(*schedule.Value)
+}
+
+// ValidateBackupSchedule checks that the value of a schedule of the frequency type is a valid BackupInterval. The
+// schedules of other types, which newer versions of the service may support, are not checked.
+func ValidateBackupSchedule(schedule *BackupSchedule) error {
+	if schedule == nil || schedule.Value == nil {
+		return nil
+	}
+	if scheduleType := schedule.GetType(); scheduleType != "" && scheduleType != BackupScheduleTypeFrequency {
+		return nil
+	}
+	_, err := ParseBackupInterval(*schedule.Value)
+	return err
+}
+
+// NextBackupTime returns the time when the next backup is expected: the creation time of the most recent backup plus
+// the interval of the schedule. A nil schedule has the DefaultBackupInterval. It fails if no backup has a creation
+// time.
+func NextBackupTime(schedule *BackupSchedule, backups []Backup) (time.Time, error) {
+	interval, err := schedule.GetInterval()
+	if err != nil {
+		return time.Time{}, err
+	}
+	var newest time.Time
+	for i := range backups {
+		createdAt, err := backups[i].GetCreatedAt()
+		if err == nil && createdAt.After(newest) {
+			newest = createdAt
+		}
+	}
+	if newest.IsZero() {
+		return time.Time{}, fmt.Errorf("no backup has a creation time")
+	}
+	return newest.Add(interval.Duration()), nil
+}
+
+// joinBackupIntervals returns the valid intervals, separated by commas.
+func joinBackupIntervals() string {
+	names := make([]string, len(BackupIntervals))
+	for i, interval := range BackupIntervals {
+		names[i] = string(interval)
+	}
+	return strings.Join(names, ", ")
+}
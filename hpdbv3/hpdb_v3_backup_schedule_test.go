@@ -0,0 +1,143 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3_test
+
+import (
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3/hpdbv3test"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`HpdbV3 backup schedule`, func() {
+	Describe(`ParseBackupInterval(value string)`, func() {
+		It(`Invoke ParseBackupInterval successfully`, func() {
+			for value, expected := range map[string]time.Duration{
+				"1h":  time.Hour,
+				"2H":  2 * time.Hour,
+				"4h":  4 * time.Hour,
+				"8h":  8 * time.Hour,
+				"1D":  24 * time.Hour,
+				"2d":  48 * time.Hour,
+				" 1W": 7 * 24 * time.Hour,
+			} {
+				interval, err := hpdbv3.ParseBackupInterval(value)
+				Expect(err).To(BeNil())
+				Expect(interval.IsValid()).To(BeTrue())
+				Expect(interval.Duration()).To(Equal(expected))
+
+				fromDuration, err := hpdbv3.BackupIntervalFromDuration(expected)
+				Expect(err).To(BeNil())
+				Expect(fromDuration).To(Equal(interval))
+			}
+		})
+		It(`Invoke ParseBackupInterval with error`, func() {
+			for _, value := range []string{"", "12h", "3d", "1m", "h"} {
+				_, err := hpdbv3.ParseBackupInterval(value)
+				Expect(err).ToNot(BeNil())
+			}
+			_, err := hpdbv3.ParseBackupInterval("12h")
+			Expect(err.Error()).To(Equal(`invalid backup interval "12h": valid intervals are 1h, 2h, 4h, 8h, 1d, 2d, 1w`))
+
+			_, err = hpdbv3.BackupIntervalFromDuration(12 * time.Hour)
+			Expect(err).ToNot(BeNil())
+			Expect(hpdbv3.BackupInterval("12h").Duration()).To(BeZero())
+		})
+	})
+	Describe(`GetInterval()`, func() {
+		It(`Invoke GetInterval successfully`, func() {
+			interval, err := hpdbv3.NewBackupSchedule(hpdbv3.BackupInterval1Day).GetInterval()
+			Expect(err).To(BeNil())
+			Expect(interval).To(Equal(hpdbv3.BackupInterval1Day))
+
+			interval, err = (&hpdbv3.BackupSchedule{Value: core.StringPtr("2H")}).GetInterval()
+			Expect(err).To(BeNil())
+			Expect(interval).To(Equal(hpdbv3.BackupInterval2Hours))
+
+			var schedule *hpdbv3.BackupSchedule
+			interval, err = schedule.GetInterval()
+			Expect(err).To(BeNil())
+			Expect(interval).To(Equal(hpdbv3.DefaultBackupInterval))
+		})
+		It(`Invoke GetInterval with error`, func() {
+			_, err := (&hpdbv3.BackupSchedule{Type: core.StringPtr("cron"), Value: core.StringPtr("0 * * * *")}).GetInterval()
+			Expect(err).ToNot(BeNil())
+			_, err = (&hpdbv3.BackupSchedule{Type: core.StringPtr("frequency"), Value: core.StringPtr("12h")}).GetInterval()
+			Expect(err).ToNot(BeNil())
+		})
+	})
+	Describe(`NextBackupTime(schedule *BackupSchedule, backups []Backup)`, func() {
+		It(`Invoke NextBackupTime successfully`, func() {
+			backups := []hpdbv3.Backup{
+				{CreatedAt: core.StringPtr("2019-01-01T12:00:00.000Z")},
+				{CreatedAt: core.StringPtr("2019-01-02T08:00:00.000Z")},
+				{CreatedAt: core.StringPtr("invalid")},
+				{},
+			}
+			next, err := hpdbv3.NextBackupTime(hpdbv3.NewBackupSchedule(hpdbv3.BackupInterval4Hours), backups)
+			Expect(err).To(BeNil())
+			Expect(next).To(Equal(time.Date(2019, time.January, 2, 12, 0, 0, 0, time.UTC)))
+
+			next, err = hpdbv3.NextBackupTime(nil, backups)
+			Expect(err).To(BeNil())
+			Expect(next).To(Equal(time.Date(2019, time.January, 2, 16, 0, 0, 0, time.UTC)))
+		})
+		It(`Invoke NextBackupTime with error`, func() {
+			_, err := hpdbv3.NextBackupTime(nil, nil)
+			Expect(err).ToNot(BeNil())
+			_, err = hpdbv3.NextBackupTime(&hpdbv3.BackupSchedule{Value: core.StringPtr("12h")}, []hpdbv3.Backup{{CreatedAt: core.StringPtr("2019-01-01T12:00:00.000Z")}})
+			Expect(err).ToNot(BeNil())
+		})
+	})
+	Describe(`EnableCosBackup and UpdateBackupConfig with an invalid schedule`, func() {
+		var server *hpdbv3test.Server
+		var hpdbService *hpdbv3.HpdbV3
+		clusterID := "9cebab98-afeb-4886-9a29-8e741716e7ff"
+
+		BeforeEach(func() {
+			server = hpdbv3test.NewServer()
+			server.AddCluster(hpdbv3test.NewCluster(clusterID))
+			var err error
+			hpdbService, err = server.NewClient()
+			Expect(err).To(BeNil())
+		})
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It(`Invoke UpdateBackupConfig with error: Invalid interval`, func() {
+			options := hpdbService.NewUpdateBackupConfigOptions(clusterID).
+				SetCos(&hpdbv3.CosBackupConfig{Schedule: &hpdbv3.BackupSchedule{Type: core.StringPtr("frequency"), Value: core.StringPtr("12h")}})
+			_, response, err := hpdbService.UpdateBackupConfig(options)
+			Expect(err).ToNot(BeNil())
+			Expect(response).To(BeNil())
+			Expect(server.Requests()).To(BeEmpty())
+		})
+		It(`Invoke EnableCosBackup with error: Invalid interval`, func() {
+			options := hpdbService.NewEnableCosBackupOptions(clusterID).
+				SetCosEndpoint("s3.us-south.cloud-object-storage.appdomain.cloud").
+				SetSchedule(&hpdbv3.BackupSchedule{Value: core.StringPtr("3d")})
+			_, response, err := hpdbService.EnableCosBackup(options)
+			Expect(err).ToNot(BeNil())
+			Expect(response).To(BeNil())
+			Expect(server.Requests()).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,345 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ConfigurationJournal : Stores the history of the configuration changes made by UpdateConfiguration, so that they can
+// be rolled back with RollbackConfiguration. FileConfigurationJournal stores it in a local file; other stores may be
+// plugged in with SetConfigurationJournal.
+type ConfigurationJournal interface {
+	// AppendEntry adds the entry to the journal as the next version of its cluster, which it sets in entry.Version. The
+	// version must be allocated atomically, so that concurrent changes of a cluster get distinct versions.
+	AppendEntry(entry *ConfigurationJournalEntry) error
+
+	// SaveEntry adds the entry to the journal, or replaces the entry of the same cluster and version.
+	SaveEntry(entry *ConfigurationJournalEntry) error
+
+	// ListEntries returns the entries of the cluster, sorted by version.
+	ListEntries(clusterID string) ([]*ConfigurationJournalEntry, error)
+}
+
+// ConfigurationJournalEntry : A configuration change recorded in a ConfigurationJournal.
+type ConfigurationJournalEntry struct {
+	// The ID of the cluster.
+	ClusterID string `json:"cluster_id"`
+
+	// The version of the configuration, which counts the changes of the cluster from 1.
+	Version int `json:"version"`
+
+	// The time of the change.
+	CreatedAt time.Time `json:"created_at"`
+
+	// The values of the parameters before the change, which RollbackConfiguration re-applies.
+	Snapshot map[string]ParameterValue `json:"snapshot"`
+
+	// The values of the parameters to be updated by the change.
+	Change map[string]ParameterValue `json:"change"`
+
+	// The ID of the task which applies the change. It is empty if the service did not accept the change.
+	TaskID string `json:"task_id,omitempty"`
+
+	// The state of the task: RUNNING until its outcome is known, then SUCCEEDED or FAILED. It is FAILED without a task
+	// ID if the service did not accept the change, and empty while the change is being sent.
+	State TaskState `json:"state,omitempty"`
+
+	// Why the change failed.
+	Error string `json:"error,omitempty"`
+}
+
+// FileConfigurationJournal : A ConfigurationJournal which stores the entries of all the clusters in a local JSON file.
+// It may be used from multiple goroutines, but not by several processes at the same time.
+type FileConfigurationJournal struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileConfigurationJournal returns a FileConfigurationJournal which stores the entries in the file at the specified
+// path. The file is created by the first change.
+func NewFileConfigurationJournal(path string) *FileConfigurationJournal {
+	return &FileConfigurationJournal{path: path}
+}
+
+// AppendEntry adds the entry to the journal as the next version of its cluster, which it sets in entry.Version.
+func (journal *FileConfigurationJournal) AppendEntry(entry *ConfigurationJournalEntry) error {
+	journal.mu.Lock()
+	defer journal.mu.Unlock()
+	entries, err := journal.read()
+	if err != nil {
+		return err
+	}
+	version := 0
+	for _, e := range entries {
+		if e.ClusterID == entry.ClusterID && e.Version > version {
+			version = e.Version
+		}
+	}
+	entry.Version = version + 1
+	return journal.write(append(entries, entry))
+}
+
+// SaveEntry adds the entry to the journal, or replaces the entry of the same cluster and version. The file is replaced
+// atomically, so that it is never left half written.
+func (journal *FileConfigurationJournal) SaveEntry(entry *ConfigurationJournalEntry) error {
+	journal.mu.Lock()
+	defer journal.mu.Unlock()
+	entries, err := journal.read()
+	if err != nil {
+		return err
+	}
+	replaced := false
+	for i, e := range entries {
+		if e.ClusterID == entry.ClusterID && e.Version == entry.Version {
+			entries[i] = entry
+			replaced = true
+		}
+	}
+	if !replaced {
+		entries = append(entries, entry)
+	}
+	return journal.write(entries)
+}
+
+// write replaces the file with the entries. The file is replaced atomically, so that it is never left half written.
+func (journal *FileConfigurationJournal) write(entries []*ConfigurationJournalEntry) error {
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	file, err := os.CreateTemp(filepath.Dir(journal.path), filepath.Base(journal.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	if _, err = file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+	if err = file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), journal.path)
+}
+
+// ListEntries returns the entries of the cluster, sorted by version.
+func (journal *FileConfigurationJournal) ListEntries(clusterID string) ([]*ConfigurationJournalEntry, error) {
+	journal.mu.Lock()
+	defer journal.mu.Unlock()
+	entries, err := journal.read()
+	if err != nil {
+		return nil, err
+	}
+	var result []*ConfigurationJournalEntry
+	for _, entry := range entries {
+		if entry.ClusterID == clusterID {
+			result = append(result, entry)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Version < result[j].Version })
+	return result, nil
+}
+
+// read returns all the entries of the file, which may not exist yet.
+func (journal *FileConfigurationJournal) read() ([]*ConfigurationJournalEntry, error) {
+	data, err := os.ReadFile(journal.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var entries []*ConfigurationJournalEntry
+	if err = json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("invalid configuration journal %s: %s", journal.path, err.Error())
+	}
+	return entries, nil
+}
+
+// SetConfigurationJournal sets the journal in which UpdateConfiguration records every change, with a snapshot of the
+// configuration before the change, which it gets with GetConfiguration. A nil journal disables the recording, which is
+// the default.
+func (hpdb *HpdbV3) SetConfigurationJournal(journal ConfigurationJournal) {
+	hpdb.configurationJournal = journal
+}
+
+// ConfigurationHistory returns the configuration changes of the cluster recorded in the journal, sorted by version.
+// The outcome of the changes whose task was still running is updated first. The changes whose task cannot be queried,
+// such as because the task has expired or the service is unavailable, are returned as they were recorded.
+func (hpdb *HpdbV3) ConfigurationHistory(ctx context.Context, clusterID string) ([]*ConfigurationJournalEntry, error) {
+	if hpdb.configurationJournal == nil {
+		return nil, fmt.Errorf("no configuration journal is set")
+	}
+	entries, err := hpdb.configurationJournal.ListEntries(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if entry.State != TaskStateRunning || entry.TaskID == "" {
+			continue
+		}
+		task, _, err := hpdb.GetTaskWithContext(ctx, hpdb.NewGetTaskOptions(clusterID, entry.TaskID))
+		if err != nil || !task.GetState().IsTerminal() {
+			continue
+		}
+		entry.State = task.GetState()
+		if entry.State == TaskStateFailed {
+			entry.Error = newTaskFailedError(clusterID, entry.TaskID, task).Error()
+		}
+		if err = hpdb.configurationJournal.SaveEntry(entry); err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
+// RollbackConfiguration : Roll back the configuration to a version of the journal
+// Update the configuration of a cluster to the values that its parameters had before the change of the specified
+// version of the journal. Only the parameters whose value differs are updated, through UpdateConfiguration, so that the
+// rollback is recorded in the journal as well. The result is nil if the configuration already has these values.
+func (hpdb *HpdbV3) RollbackConfiguration(clusterID string, toVersion int) (result *TaskID, response *core.DetailedResponse, err error) {
+	return hpdb.RollbackConfigurationWithContext(context.Background(), clusterID, toVersion)
+}
+
+// RollbackConfigurationWithContext is an alternate form of the RollbackConfiguration method which supports a Context parameter
+func (hpdb *HpdbV3) RollbackConfigurationWithContext(ctx context.Context, clusterID string, toVersion int) (result *TaskID, response *core.DetailedResponse, err error) {
+	if clusterID == "" {
+		err = fmt.Errorf("clusterID cannot be empty")
+		return
+	}
+	if hpdb.configurationJournal == nil {
+		err = fmt.Errorf("no configuration journal is set")
+		return
+	}
+	entries, err := hpdb.configurationJournal.ListEntries(clusterID)
+	if err != nil {
+		return
+	}
+	var snapshot map[string]ParameterValue
+	for _, entry := range entries {
+		if entry.Version == toVersion {
+			snapshot = entry.Snapshot
+		}
+	}
+	if snapshot == nil {
+		err = fmt.Errorf("version %d of the configuration of cluster %s is not in the journal", toVersion, clusterID)
+		return
+	}
+
+	current, response, err := hpdb.GetConfigurationWithContext(ctx, hpdb.NewGetConfigurationOptions(clusterID))
+	if err != nil {
+		return
+	}
+	desired := &Configurations{}
+	for name, value := range snapshot {
+		desired.SetParameter(name, value)
+	}
+	changes, err := ValidateConfigurationChange(current, desired)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(changes) == 0 {
+		return nil, response, nil
+	}
+	configurations := &Configurations{}
+	for _, change := range changes {
+		configurations.SetParameter(change.Name, change.To)
+	}
+	return hpdb.UpdateConfigurationWithContext(ctx, hpdb.NewUpdateConfigurationOptions(clusterID).SetConfiguration(configurations))
+}
+
+// journalUpdateConfiguration records the configuration change to be made by UpdateConfiguration in the journal, with a
+// snapshot of the current configuration, and the response is the one of GetConfiguration if that fails.
+func (hpdb *HpdbV3) journalUpdateConfiguration(ctx context.Context, updateConfigurationOptions *UpdateConfigurationOptions) (entry *ConfigurationJournalEntry, response *core.DetailedResponse, err error) {
+	clusterID := *updateConfigurationOptions.ClusterID
+	getConfigurationOptions := hpdb.NewGetConfigurationOptions(clusterID)
+	getConfigurationOptions.SetHeaders(updateConfigurationOptions.Headers)
+	current, response, err := hpdb.GetConfigurationWithContext(ctx, getConfigurationOptions)
+	if err != nil {
+		return
+	}
+	if current.Configuration == nil {
+		return nil, nil, fmt.Errorf("the configuration of cluster %s is unknown", clusterID)
+	}
+
+	entry = &ConfigurationJournalEntry{
+		ClusterID: clusterID,
+		CreatedAt: time.Now().UTC(),
+		Snapshot:  make(map[string]ParameterValue),
+		Change:    updateConfigurationOptions.Configuration.GetParameters(),
+	}
+	for name, parameter := range current.Configuration.GetParameters() {
+		if parameter.Value.IsSet() {
+			entry.Snapshot[name] = parameter.Value
+		}
+	}
+	if err = hpdb.configurationJournal.AppendEntry(entry); err != nil {
+		return nil, nil, err
+	}
+	return entry, nil, nil
+}
+
+// recordConfigurationChange records the outcome of the request of UpdateConfiguration in the journal entry. It returns
+// the error of the request, or the error of the journal if the request succeeded.
+func (hpdb *HpdbV3) recordConfigurationChange(entry *ConfigurationJournalEntry, result *TaskID, err error) error {
+	switch {
+	case err != nil:
+		entry.State = TaskStateFailed
+		entry.Error = err.Error()
+	case result != nil && result.TaskID != nil:
+		entry.TaskID = *result.TaskID
+		entry.State = TaskStateRunning
+	}
+	saveErr := hpdb.configurationJournal.SaveEntry(entry)
+	if err != nil || saveErr == nil {
+		return err
+	}
+	return fmt.Errorf("the configuration change was sent to the service, but not recorded in the journal: %s", saveErr.Error())
+}
+
+// recordConfigurationOutcome records the final state of the task of an operation in the journal entry of the change.
+func (hpdb *HpdbV3) recordConfigurationOutcome(operation *Operation) {
+	task, taskErr := operation.Result()
+	if task == nil {
+		return
+	}
+	entries, err := hpdb.configurationJournal.ListEntries(operation.ClusterID())
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		if entry.TaskID != operation.ID() {
+			continue
+		}
+		entry.State = task.GetState()
+		if taskErr != nil {
+			entry.Error = taskErr.Error()
+		}
+		_ = hpdb.configurationJournal.SaveEntry(entry)
+	}
+}
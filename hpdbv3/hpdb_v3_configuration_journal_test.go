@@ -0,0 +1,257 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3/hpdbv3test"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`HpdbV3 configuration journal`, func() {
+	var server *hpdbv3test.Server
+	var hpdbService *hpdbv3.HpdbV3
+	var dir string
+	var journal *hpdbv3.FileConfigurationJournal
+	clusterID := "9cebab98-afeb-4886-9a29-8e741716e7ff"
+	configurationPath := "/clusters/" + clusterID + "/configuration"
+	fastWait := &hpdbv3.WaitForTaskOptions{Interval: time.Millisecond}
+
+	BeforeEach(func() {
+		server = hpdbv3test.NewServer()
+		server.SetTaskDuration(0)
+		server.AddCluster(hpdbv3test.NewCluster(clusterID))
+		var err error
+		hpdbService, err = server.NewClient()
+		Expect(err).To(BeNil())
+		dir, err = os.MkdirTemp("", "journal")
+		Expect(err).To(BeNil())
+		journal = hpdbv3.NewFileConfigurationJournal(filepath.Join(dir, "journal.json"))
+		hpdbService.SetConfigurationJournal(journal)
+	})
+	AfterEach(func() {
+		server.Close()
+		os.RemoveAll(dir)
+	})
+
+	updateConfiguration := func(configurations *hpdbv3.Configurations) *hpdbv3.Operation {
+		options := hpdbService.NewUpdateConfigurationOptions(clusterID).SetConfiguration(configurations)
+		operation, _, err := hpdbService.UpdateConfigurationAndWait(context.Background(), options, fastWait)
+		Expect(err).To(BeNil())
+		return operation
+	}
+
+	Describe(`AppendEntry(entry *ConfigurationJournalEntry)`, func() {
+		It(`Invoke AppendEntry successfully from concurrent goroutines`, func() {
+			var wg sync.WaitGroup
+			for i := 0; i < 20; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					defer GinkgoRecover()
+					Expect(journal.AppendEntry(&hpdbv3.ConfigurationJournalEntry{ClusterID: clusterID})).To(Succeed())
+				}()
+			}
+			Expect(journal.AppendEntry(&hpdbv3.ConfigurationJournalEntry{ClusterID: "other-cluster"})).To(Succeed())
+			wg.Wait()
+
+			// Every entry gets its own version
+			entries, err := journal.ListEntries(clusterID)
+			Expect(err).To(BeNil())
+			Expect(entries).To(HaveLen(20))
+			var versions []int
+			for _, entry := range entries {
+				versions = append(versions, entry.Version)
+			}
+			Expect(sort.IntsAreSorted(versions)).To(BeTrue())
+			Expect(versions[0]).To(Equal(1))
+			Expect(versions[19]).To(Equal(20))
+
+			entries, err = journal.ListEntries("other-cluster")
+			Expect(err).To(BeNil())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].Version).To(Equal(1))
+		})
+	})
+	Describe(`UpdateConfiguration(updateConfigurationOptions *UpdateConfigurationOptions) with a journal`, func() {
+		It(`Invoke UpdateConfiguration successfully`, func() {
+			operation := updateConfiguration(&hpdbv3.Configurations{MaxConnections: core.Int64Ptr(200)})
+			Expect(server.Requests()[:2]).To(Equal([]string{"GET " + configurationPath, "PATCH " + configurationPath}))
+
+			entries, err := journal.ListEntries(clusterID)
+			Expect(err).To(BeNil())
+			Expect(entries).To(HaveLen(1))
+			entry := entries[0]
+			Expect(entry.ClusterID).To(Equal(clusterID))
+			Expect(entry.Version).To(Equal(1))
+			Expect(entry.Snapshot["max_connections"]).To(Equal(hpdbv3.IntegerValue(115)))
+			Expect(entry.Snapshot["log_connections"]).To(Equal(hpdbv3.BoolValue(false)))
+			Expect(entry.Change).To(Equal(map[string]hpdbv3.ParameterValue{"max_connections": hpdbv3.IntegerValue(200)}))
+			Expect(entry.TaskID).To(Equal(operation.ID()))
+			Expect(entry.State).To(Equal(hpdbv3.TaskStateSucceeded))
+			Expect(entry.Error).To(BeEmpty())
+
+			updateConfiguration((&hpdbv3.Configurations{}).SetParameter("log_min_messages", hpdbv3.StringValue("error")))
+			entries, err = journal.ListEntries(clusterID)
+			Expect(err).To(BeNil())
+			Expect(entries).To(HaveLen(2))
+			Expect(entries[1].Version).To(Equal(2))
+			Expect(entries[1].Snapshot["max_connections"]).To(Equal(hpdbv3.IntegerValue(200)))
+
+			// The entries are kept by a new journal on the same file
+			entries, err = hpdbv3.NewFileConfigurationJournal(filepath.Join(dir, "journal.json")).ListEntries(clusterID)
+			Expect(err).To(BeNil())
+			Expect(entries).To(HaveLen(2))
+			Expect(entries[1].Snapshot["log_min_messages"]).To(Equal(hpdbv3.StringValue("warning")))
+		})
+		It(`Invoke UpdateConfiguration successfully without a journal`, func() {
+			hpdbService.SetConfigurationJournal(nil)
+			updateConfiguration(&hpdbv3.Configurations{MaxConnections: core.Int64Ptr(200)})
+			Expect(server.Requests()[0]).To(Equal("PATCH " + configurationPath))
+			_, err := os.Stat(filepath.Join(dir, "journal.json"))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+		It(`Invoke UpdateConfiguration with error: Rejected change`, func() {
+			options := hpdbService.NewUpdateConfigurationOptions(clusterID).SetConfiguration(&hpdbv3.Configurations{MaxConnections: core.Int64Ptr(0)})
+			_, _, err := hpdbService.UpdateConfiguration(options)
+			Expect(err).ToNot(BeNil())
+
+			entries, err := journal.ListEntries(clusterID)
+			Expect(err).To(BeNil())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].TaskID).To(BeEmpty())
+			Expect(entries[0].State).To(Equal(hpdbv3.TaskStateFailed))
+			Expect(entries[0].Error).ToNot(BeEmpty())
+		})
+		It(`Invoke UpdateConfiguration with error: Failed task`, func() {
+			server.FailNextTask("invalid configuration")
+			options := hpdbService.NewUpdateConfigurationOptions(clusterID).SetConfiguration(&hpdbv3.Configurations{MaxConnections: core.Int64Ptr(200)})
+			_, _, err := hpdbService.UpdateConfigurationAndWait(context.Background(), options, fastWait)
+			Expect(err).ToNot(BeNil())
+
+			entries, err := journal.ListEntries(clusterID)
+			Expect(err).To(BeNil())
+			Expect(entries[0].State).To(Equal(hpdbv3.TaskStateFailed))
+			Expect(entries[0].Error).To(ContainSubstring("invalid configuration"))
+		})
+	})
+	Describe(`ConfigurationHistory(ctx context.Context, clusterID string)`, func() {
+		It(`Invoke ConfigurationHistory successfully`, func() {
+			server.SetTaskDuration(time.Minute)
+			options := hpdbService.NewUpdateConfigurationOptions(clusterID).SetConfiguration(&hpdbv3.Configurations{MaxConnections: core.Int64Ptr(200)})
+			taskID, _, err := hpdbService.UpdateConfiguration(options)
+			Expect(err).To(BeNil())
+
+			entries, err := hpdbService.ConfigurationHistory(context.Background(), clusterID)
+			Expect(err).To(BeNil())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].TaskID).To(Equal(*taskID.TaskID))
+			Expect(entries[0].State).To(Equal(hpdbv3.TaskStateRunning))
+
+			// The outcome is recorded once the task has finished
+			server.Advance(time.Minute)
+			entries, err = hpdbService.ConfigurationHistory(context.Background(), clusterID)
+			Expect(err).To(BeNil())
+			Expect(entries[0].State).To(Equal(hpdbv3.TaskStateSucceeded))
+			entries, err = journal.ListEntries(clusterID)
+			Expect(err).To(BeNil())
+			Expect(entries[0].State).To(Equal(hpdbv3.TaskStateSucceeded))
+		})
+		It(`Invoke ConfigurationHistory successfully with a task which cannot be queried`, func() {
+			server.SetTaskDuration(time.Minute)
+			options := hpdbService.NewUpdateConfigurationOptions(clusterID).SetConfiguration(&hpdbv3.Configurations{MaxConnections: core.Int64Ptr(200)})
+			_, _, err := hpdbService.UpdateConfiguration(options)
+			Expect(err).To(BeNil())
+			server.Advance(time.Minute)
+
+			// The task has expired: the entry is returned as it was recorded
+			server.InjectFault(hpdbv3test.Fault{Method: "GET", Route: "/clusters/{}/tasks/{}", StatusCode: 404, Times: 1})
+			entries, err := hpdbService.ConfigurationHistory(context.Background(), clusterID)
+			Expect(err).To(BeNil())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].State).To(Equal(hpdbv3.TaskStateRunning))
+
+			entries, err = hpdbService.ConfigurationHistory(context.Background(), clusterID)
+			Expect(err).To(BeNil())
+			Expect(entries[0].State).To(Equal(hpdbv3.TaskStateSucceeded))
+		})
+		It(`Invoke ConfigurationHistory with error: No journal`, func() {
+			hpdbService.SetConfigurationJournal(nil)
+			_, err := hpdbService.ConfigurationHistory(context.Background(), clusterID)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+	Describe(`RollbackConfiguration(clusterID string, toVersion int)`, func() {
+		It(`Invoke RollbackConfiguration successfully`, func() {
+			updateConfiguration(&hpdbv3.Configurations{MaxConnections: core.Int64Ptr(200)})
+			updateConfiguration((&hpdbv3.Configurations{MaxConnections: core.Int64Ptr(300)}).
+				SetParameter("log_connections", hpdbv3.BoolValue(true)))
+
+			taskID, _, err := hpdbService.RollbackConfiguration(clusterID, 1)
+			Expect(err).To(BeNil())
+			Expect(taskID).ToNot(BeNil())
+			tasks := server.Tasks(clusterID)
+			Expect(tasks).To(HaveLen(3))
+
+			configuration, _, err := hpdbService.GetConfiguration(hpdbService.NewGetConfigurationOptions(clusterID))
+			Expect(err).To(BeNil())
+			Expect(*configuration.Configuration.MaxConnections.Value).To(Equal(int64(115)))
+			Expect(configuration.Configuration.GetParameter("log_connections").Value).To(Equal(hpdbv3.BoolValue(false)))
+
+			// Only the changed parameters are updated, and the rollback is recorded as well
+			entries, err := journal.ListEntries(clusterID)
+			Expect(err).To(BeNil())
+			Expect(entries).To(HaveLen(3))
+			Expect(entries[2].Change).To(Equal(map[string]hpdbv3.ParameterValue{
+				"max_connections": hpdbv3.IntegerValue(115),
+				"log_connections": hpdbv3.BoolValue(false),
+			}))
+			Expect(entries[2].Snapshot["max_connections"]).To(Equal(hpdbv3.IntegerValue(300)))
+		})
+		It(`Invoke RollbackConfiguration successfully with no changes`, func() {
+			updateConfiguration(&hpdbv3.Configurations{MaxConnections: core.Int64Ptr(200)})
+			taskID, _, err := hpdbService.RollbackConfiguration(clusterID, 1)
+			Expect(err).To(BeNil())
+			Expect(taskID).ToNot(BeNil())
+
+			taskID, _, err = hpdbService.RollbackConfiguration(clusterID, 1)
+			Expect(err).To(BeNil())
+			Expect(taskID).To(BeNil())
+		})
+		It(`Invoke RollbackConfiguration with error: Unknown version`, func() {
+			updateConfiguration(&hpdbv3.Configurations{MaxConnections: core.Int64Ptr(200)})
+			_, _, err := hpdbService.RollbackConfiguration(clusterID, 2)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("not in the journal"))
+
+			_, _, err = hpdbService.RollbackConfiguration("", 1)
+			Expect(err).ToNot(BeNil())
+			hpdbService.SetConfigurationJournal(nil)
+			_, _, err = hpdbService.RollbackConfiguration(clusterID, 1)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
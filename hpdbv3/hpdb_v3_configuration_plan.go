@@ -0,0 +1,183 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ConfigurationPlanner : Computes the plan to bring the database configuration of a cluster to a desired state, and
+// applies it. Plan reads the configuration without changing anything, so that the plan can be reviewed or approved
+// before it is applied with ConfigurationPlan.Apply.
+type ConfigurationPlanner struct {
+	hpdb      *HpdbV3
+	clusterID string
+	desired   *Configurations
+	headers   map[string]string
+}
+
+// NewConfigurationPlanner returns a ConfigurationPlanner which updates the configuration of the cluster to the desired
+// values. The parameters which are not set in the desired configuration are not changed.
+func (hpdb *HpdbV3) NewConfigurationPlanner(clusterID string, desired *Configurations) *ConfigurationPlanner {
+	return &ConfigurationPlanner{
+		hpdb:      hpdb,
+		clusterID: clusterID,
+		desired:   desired,
+	}
+}
+
+// SetHeaders : Allow user to set the headers of the API requests
+func (planner *ConfigurationPlanner) SetHeaders(headers map[string]string) *ConfigurationPlanner {
+	planner.headers = headers
+	return planner
+}
+
+// ConfigurationPlan : The plan to update the database configuration of a cluster, computed by ConfigurationPlanner.Plan.
+type ConfigurationPlan struct {
+	// The ID of the cluster.
+	ClusterID string
+
+	// The configuration of the cluster when the plan was made.
+	Current *Configuration
+
+	// The desired configuration.
+	Desired *Configurations
+
+	// The parameters whose value changes, sorted by name. It is empty if the cluster already has the desired
+	// configuration.
+	Changes ConfigurationChanges
+
+	planner *ConfigurationPlanner
+}
+
+// Plan computes the plan to update the configuration of the cluster to the desired values. It fails if the desired
+// values are not valid, as checked by ValidateConfigurationChange, or if a parameter changes while a task is running on
+// the cluster. A plan with no changes is made even if a task is running, since its Apply does nothing.
+func (planner *ConfigurationPlanner) Plan(ctx context.Context) (plan *ConfigurationPlan, err error) {
+	if planner.clusterID == "" {
+		return nil, fmt.Errorf("clusterID cannot be empty")
+	}
+	if planner.desired == nil {
+		return nil, fmt.Errorf("desired configuration cannot be nil")
+	}
+	current, err := planner.currentConfiguration(ctx)
+	if err != nil {
+		return
+	}
+	changes, err := ValidateConfigurationChange(current, planner.desired)
+	if err != nil {
+		return
+	}
+	if len(changes) > 0 {
+		if err = planner.hpdb.checkNoRunningTask(ctx, planner.clusterID, planner.headers); err != nil {
+			return
+		}
+	}
+	return &ConfigurationPlan{
+		ClusterID: planner.clusterID,
+		Current:   current,
+		Desired:   planner.desired,
+		Changes:   changes,
+		planner:   planner,
+	}, nil
+}
+
+// IsEmpty returns true if the cluster already has the desired configuration, so that Apply has nothing to do.
+func (plan *ConfigurationPlan) IsEmpty() bool {
+	return len(plan.Changes) == 0
+}
+
+// String returns a human-readable description of the plan.
+func (plan *ConfigurationPlan) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Configuration plan for cluster %s:\n", plan.ClusterID)
+	if plan.IsEmpty() {
+		b.WriteString("  No changes: the cluster already has the desired configuration.\n")
+		return b.String()
+	}
+	width := 0
+	for _, change := range plan.Changes {
+		if len(change.Name) > width {
+			width = len(change.Name)
+		}
+	}
+	b.WriteString("  Changes:\n")
+	for _, change := range plan.Changes {
+		from := change.From.String()
+		if !change.From.IsSet() {
+			from = "unknown"
+		}
+		fmt.Fprintf(&b, "    %-*s %s -> %s", width+1, change.Name+":", from, change.To)
+		if change.RequiresRestart {
+			b.WriteString(" (requires restart)")
+		}
+		b.WriteString("\n")
+	}
+	if restart := plan.Changes.RestartParameters(); len(restart) > 0 {
+		fmt.Fprintf(&b, "  Warning: the database server will restart to apply %s.\n", strings.Join(restart, ", "))
+	}
+	return b.String()
+}
+
+// Apply updates the changed parameters of the plan with UpdateConfiguration, and waits for its task to finish. It
+// fails without making any change if the changed parameters were updated since the plan was made, or if a task is
+// running on the cluster. An empty plan does not make any API request, and returns a nil operation.
+func (plan *ConfigurationPlan) Apply(ctx context.Context, waitForTaskOptions *WaitForTaskOptions) (operation *Operation, err error) {
+	if plan.IsEmpty() {
+		return
+	}
+	planner := plan.planner
+	if planner == nil {
+		return nil, fmt.Errorf("the plan was not made by ConfigurationPlanner.Plan")
+	}
+	current, err := planner.currentConfiguration(ctx)
+	if err != nil {
+		return
+	}
+	configurations := &Configurations{}
+	for _, change := range plan.Changes {
+		parameter := current.Configuration.GetParameter(change.Name)
+		if parameter == nil || !parameter.Value.equal(change.From) {
+			return nil, fmt.Errorf("the configuration of cluster %s changed since the plan was made; make a new plan", plan.ClusterID)
+		}
+		configurations.SetParameter(change.Name, change.To)
+	}
+	if err = planner.hpdb.checkNoRunningTask(ctx, plan.ClusterID, planner.headers); err != nil {
+		return
+	}
+
+	updateConfigurationOptions := planner.hpdb.NewUpdateConfigurationOptions(plan.ClusterID).SetConfiguration(configurations)
+	updateConfigurationOptions.SetHeaders(planner.headers)
+	operation, _, err = planner.hpdb.UpdateConfigurationAndWait(ctx, updateConfigurationOptions, waitForTaskOptions)
+	return
+}
+
+// currentConfiguration returns the configuration of the cluster.
+func (planner *ConfigurationPlanner) currentConfiguration(ctx context.Context) (*Configuration, error) {
+	getConfigurationOptions := planner.hpdb.NewGetConfigurationOptions(planner.clusterID)
+	getConfigurationOptions.SetHeaders(planner.headers)
+	configuration, _, err := planner.hpdb.GetConfigurationWithContext(ctx, getConfigurationOptions)
+	if err != nil {
+		return nil, err
+	}
+	if configuration.Configuration == nil {
+		return nil, fmt.Errorf("the configuration of cluster %s is unknown", planner.clusterID)
+	}
+	return configuration, nil
+}
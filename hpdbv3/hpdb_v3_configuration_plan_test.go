@@ -0,0 +1,162 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3/hpdbv3test"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`HpdbV3 configuration planner`, func() {
+	var server *hpdbv3test.Server
+	var hpdbService *hpdbv3.HpdbV3
+	clusterID := "9cebab98-afeb-4886-9a29-8e741716e7ff"
+	configurationPath := "/clusters/" + clusterID + "/configuration"
+	fastWait := &hpdbv3.WaitForTaskOptions{Interval: time.Millisecond}
+
+	BeforeEach(func() {
+		server = hpdbv3test.NewServer()
+		server.SetTaskDuration(0)
+		server.AddCluster(hpdbv3test.NewCluster(clusterID))
+		var err error
+		hpdbService, err = server.NewClient()
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe(`Plan(ctx context.Context)`, func() {
+		It(`Invoke Plan successfully`, func() {
+			desired := (&hpdbv3.Configurations{MaxConnections: core.Int64Ptr(200), DeadlockTimeout: core.Int64Ptr(1000)}).
+				SetParameter("log_min_messages", hpdbv3.StringValue("error"))
+			plan, err := hpdbService.NewConfigurationPlanner(clusterID, desired).Plan(context.Background())
+			Expect(err).To(BeNil())
+			Expect(plan.IsEmpty()).To(BeFalse())
+			Expect(plan.Changes).To(Equal(hpdbv3.ConfigurationChanges{
+				{Name: "log_min_messages", From: hpdbv3.StringValue("warning"), To: hpdbv3.StringValue("error")},
+				{Name: "max_connections", From: hpdbv3.IntegerValue(115), To: hpdbv3.IntegerValue(200), RequiresRestart: true},
+			}))
+			Expect(plan.String()).To(Equal("Configuration plan for cluster " + clusterID + ":\n" +
+				"  Changes:\n" +
+				"    log_min_messages: warning -> error\n" +
+				"    max_connections:  115 -> 200 (requires restart)\n" +
+				"  Warning: the database server will restart to apply max_connections.\n"))
+
+			// Planning does not change anything
+			Expect(server.Requests()).To(Equal([]string{"GET " + configurationPath, "GET /clusters/" + clusterID + "/tasks"}))
+		})
+		It(`Invoke Plan successfully with no changes`, func() {
+			plan, err := hpdbService.NewConfigurationPlanner(clusterID, &hpdbv3.Configurations{MaxConnections: core.Int64Ptr(115)}).Plan(context.Background())
+			Expect(err).To(BeNil())
+			Expect(plan.IsEmpty()).To(BeTrue())
+			Expect(plan.String()).To(ContainSubstring("No changes"))
+		})
+		It(`Invoke Plan with error: Invalid desired configuration`, func() {
+			_, err := hpdbService.NewConfigurationPlanner(clusterID, &hpdbv3.Configurations{MaxConnections: core.Int64Ptr(0)}).Plan(context.Background())
+			Expect(err).To(BeAssignableToTypeOf(&hpdbv3.ConfigurationValidationError{}))
+
+			_, err = hpdbService.NewConfigurationPlanner(clusterID, nil).Plan(context.Background())
+			Expect(err).ToNot(BeNil())
+			_, err = hpdbService.NewConfigurationPlanner("", &hpdbv3.Configurations{}).Plan(context.Background())
+			Expect(err).ToNot(BeNil())
+		})
+		It(`Invoke Plan with error: Running task`, func() {
+			server.SetTaskDuration(time.Minute)
+			taskID, _, err := hpdbService.ScaleResources(hpdbService.NewScaleResourcesOptions(clusterID).SetResource(&hpdbv3.Resources{Cpu: core.Int64Ptr(4)}))
+			Expect(err).To(BeNil())
+
+			_, err = hpdbService.NewConfigurationPlanner(clusterID, &hpdbv3.Configurations{MaxConnections: core.Int64Ptr(200)}).Plan(context.Background())
+			var busyErr *hpdbv3.ClusterBusyError
+			Expect(errors.As(err, &busyErr)).To(BeTrue())
+			Expect(busyErr.TaskIDs).To(Equal([]string{*taskID.TaskID}))
+
+			// A plan with no changes does not check the tasks
+			plan, err := hpdbService.NewConfigurationPlanner(clusterID, &hpdbv3.Configurations{MaxConnections: core.Int64Ptr(115)}).Plan(context.Background())
+			Expect(err).To(BeNil())
+			Expect(plan.IsEmpty()).To(BeTrue())
+		})
+	})
+	Describe(`Apply(ctx context.Context, waitForTaskOptions *WaitForTaskOptions)`, func() {
+		It(`Invoke Apply successfully`, func() {
+			desired := (&hpdbv3.Configurations{MaxConnections: core.Int64Ptr(200), DeadlockTimeout: core.Int64Ptr(1000)}).
+				SetParameter("log_connections", hpdbv3.BoolValue(true))
+			plan, err := hpdbService.NewConfigurationPlanner(clusterID, desired).Plan(context.Background())
+			Expect(err).To(BeNil())
+
+			operation, err := plan.Apply(context.Background(), fastWait)
+			Expect(err).To(BeNil())
+			task, err := operation.Result()
+			Expect(err).To(BeNil())
+			Expect(task.GetState()).To(Equal(hpdbv3.TaskStateSucceeded))
+
+			// Only the changed parameters are updated
+			Expect(server.Tasks(clusterID)).To(HaveLen(1))
+			Expect(task.Spec["configuration"]).To(Equal(map[string]interface{}{"max_connections": float64(200), "log_connections": true}))
+
+			configuration, _, err := hpdbService.GetConfiguration(hpdbService.NewGetConfigurationOptions(clusterID))
+			Expect(err).To(BeNil())
+			Expect(*configuration.Configuration.MaxConnections.Value).To(Equal(int64(200)))
+			Expect(configuration.Configuration.GetParameter("log_connections").Value).To(Equal(hpdbv3.BoolValue(true)))
+		})
+		It(`Invoke Apply successfully with no changes`, func() {
+			plan, err := hpdbService.NewConfigurationPlanner(clusterID, &hpdbv3.Configurations{MaxConnections: core.Int64Ptr(115)}).Plan(context.Background())
+			Expect(err).To(BeNil())
+			requests := len(server.Requests())
+
+			operation, err := plan.Apply(context.Background(), fastWait)
+			Expect(err).To(BeNil())
+			Expect(operation).To(BeNil())
+			Expect(server.Requests()).To(HaveLen(requests))
+		})
+		It(`Invoke Apply with error: Stale plan`, func() {
+			plan, err := hpdbService.NewConfigurationPlanner(clusterID, &hpdbv3.Configurations{MaxConnections: core.Int64Ptr(200)}).Plan(context.Background())
+			Expect(err).To(BeNil())
+			configuration := hpdbv3test.NewConfiguration()
+			configuration.MaxConnections.Value = core.Int64Ptr(150)
+			server.SetConfiguration(clusterID, configuration)
+
+			operation, err := plan.Apply(context.Background(), fastWait)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("changed since the plan was made"))
+			Expect(operation).To(BeNil())
+			Expect(server.Tasks(clusterID)).To(BeEmpty())
+		})
+		It(`Invoke Apply with error: Failed task`, func() {
+			plan, err := hpdbService.NewConfigurationPlanner(clusterID, &hpdbv3.Configurations{MaxConnections: core.Int64Ptr(200)}).Plan(context.Background())
+			Expect(err).To(BeNil())
+			server.FailNextTask("invalid configuration")
+
+			operation, err := plan.Apply(context.Background(), fastWait)
+			var taskFailedErr *hpdbv3.TaskFailedError
+			Expect(errors.As(err, &taskFailedErr)).To(BeTrue())
+			Expect(operation).ToNot(BeNil())
+		})
+		It(`Invoke Apply with error: Plan not made by Plan`, func() {
+			plan := &hpdbv3.ConfigurationPlan{ClusterID: clusterID, Changes: hpdbv3.ConfigurationChanges{{Name: "max_connections", To: hpdbv3.IntegerValue(200)}}}
+			_, err := plan.Apply(context.Background(), fastWait)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
@@ -0,0 +1,304 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// farOffFactor is how many times smaller or larger than the recommended value a current value must be to be flagged.
+const farOffFactor = 4
+
+// ConfigurationRecommender : Recommends the values of shared_buffers, max_connections and max_locks_per_transaction for
+// the CPU and memory of a cluster. The fields tune the recommendations; NewConfigurationRecommender sets them to the
+// usual PostgreSQL guidelines.
+type ConfigurationRecommender struct {
+	// The fraction of the memory given to shared_buffers.
+	SharedBuffersRatio float64
+
+	// The number of connections per CPU.
+	ConnectionsPerCpu int64
+
+	// The memory needed by a connection, out of the memory which is not given to shared_buffers.
+	MemoryPerConnection Quantity
+
+	// The maximum number of connections by plan ID, for the plans which limit them.
+	PlanMaxConnections map[string]int64
+}
+
+// NewConfigurationRecommender returns a ConfigurationRecommender which gives a quarter of the memory to shared_buffers,
+// and allows 50 connections per CPU, each with 16MiB of memory.
+func NewConfigurationRecommender() *ConfigurationRecommender {
+	return &ConfigurationRecommender{
+		SharedBuffersRatio:  0.25,
+		ConnectionsPerCpu:   50,
+		MemoryPerConnection: 16 * MiB,
+	}
+}
+
+// ParameterRecommendation : The recommended value of a configuration parameter.
+type ParameterRecommendation struct {
+	// The name of the parameter.
+	Name string
+
+	// The current value.
+	Current ParameterValue
+
+	// The recommended value, within the minimum and maximum of the parameter.
+	Recommended ParameterValue
+
+	// How the recommended value was computed.
+	Rationale string
+}
+
+// ConfigurationWarning : A current value of a configuration parameter which is far from the recommended one.
+type ConfigurationWarning struct {
+	// The name of the parameter.
+	Parameter string
+
+	// The current value.
+	Value ParameterValue
+
+	// What is wrong with the value.
+	Message string
+}
+
+// ConfigurationRecommendation : The configuration recommended for a cluster by a ConfigurationRecommender.
+type ConfigurationRecommendation struct {
+	// The ID of the cluster.
+	ClusterID string
+
+	// The parameters whose recommended value differs from the current one, to be updated with UpdateConfiguration.
+	Configurations *Configurations
+
+	// The recommended values of all the parameters, sorted by name.
+	Recommendations []ParameterRecommendation
+
+	// The current values which are far from the recommended ones, such as a shared_buffers larger than the memory of
+	// the cluster after its memory was scaled down.
+	Warnings []ConfigurationWarning
+}
+
+// IsEmpty returns true if the cluster already has the recommended configuration.
+func (recommendation *ConfigurationRecommendation) IsEmpty() bool {
+	return len(recommendation.Configurations.GetParameters()) == 0
+}
+
+// String returns a human-readable description of the recommendation.
+func (recommendation *ConfigurationRecommendation) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Configuration recommendation for cluster %s:\n", recommendation.ClusterID)
+	for _, r := range recommendation.Recommendations {
+		current := r.Current.String()
+		if !r.Current.IsSet() {
+			current = "unknown"
+		}
+		fmt.Fprintf(&b, "  %s: %s -> %s\n    %s\n", r.Name, current, r.Recommended, r.Rationale)
+	}
+	for _, warning := range recommendation.Warnings {
+		fmt.Fprintf(&b, "  Warning: %s\n", warning.Message)
+	}
+	return b.String()
+}
+
+// Recommend returns the configuration recommended for the CPU and memory of the cluster, and the current values which
+// are far from it. The current configuration, as returned by GetConfiguration, gives the minimum and maximum of the
+// parameters. shared_buffers and work_mem are counted in the unit reported by the service or else the one of
+// ParameterUnits, such as 8kB: shared_buffers is only recommended, and both are only checked, if it is a memory unit.
+func (recommender *ConfigurationRecommender) Recommend(cluster *Cluster, current *Configuration) (*ConfigurationRecommendation, error) {
+	if cluster == nil || cluster.Resource == nil || cluster.Resource.Cpu == nil {
+		return nil, fmt.Errorf("the resources of the cluster are unknown")
+	}
+	if current == nil || current.Configuration == nil {
+		return nil, fmt.Errorf("the current configuration is unknown")
+	}
+	memory, err := cluster.Resource.GetMemory()
+	if err != nil {
+		return nil, err
+	}
+	if memory <= 0 {
+		return nil, fmt.Errorf("the memory of the cluster is unknown")
+	}
+	cpu := *cluster.Resource.Cpu
+	item := current.Configuration
+	recommendation := &ConfigurationRecommendation{Configurations: &Configurations{}}
+	if cluster.ID != nil {
+		recommendation.ClusterID = *cluster.ID
+	}
+	recommend := func(name string, value int64, rationale string) int64 {
+		parameter := item.GetParameter(name)
+		if parameter == nil {
+			return value
+		}
+		if parameter.Min != nil && value < *parameter.Min {
+			value = *parameter.Min
+			rationale += fmt.Sprintf(", raised to the minimum %d", value)
+		}
+		if parameter.Max != nil && value > *parameter.Max {
+			value = *parameter.Max
+			rationale += fmt.Sprintf(", lowered to the maximum %d", value)
+		}
+		recommendation.Recommendations = append(recommendation.Recommendations, ParameterRecommendation{
+			Name:        name,
+			Current:     parameter.Value,
+			Recommended: IntegerValue(value),
+			Rationale:   rationale,
+		})
+		if !parameter.Value.equal(IntegerValue(value)) {
+			recommendation.Configurations.SetParameter(name, IntegerValue(value))
+		}
+		return value
+	}
+
+	// Ordered by name, as the recommendations are.
+	maxConnections := recommender.maxConnections(cluster, cpu, memory)
+	maxConnections.value = recommend("max_connections", maxConnections.value, maxConnections.rationale)
+	locks := maxLocksPerTransaction(memory)
+	recommend("max_locks_per_transaction", locks.value, locks.rationale)
+	sharedBuffers, ok := recommender.sharedBuffers(item, memory)
+	if ok {
+		sharedBuffers.value = recommend("shared_buffers", sharedBuffers.value, sharedBuffers.rationale)
+	}
+
+	recommendation.Warnings = checkConfiguration(item, memory, sharedBuffers.value, maxConnections.value)
+	return recommendation, nil
+}
+
+// RecommendConfiguration returns the configuration recommended by NewConfigurationRecommender for the CPU and memory
+// of a cluster, with its current values which are far from it.
+func (hpdb *HpdbV3) RecommendConfiguration(ctx context.Context, clusterID string) (*ConfigurationRecommendation, error) {
+	cluster, _, err := hpdb.GetClusterWithContext(ctx, hpdb.NewGetClusterOptions(clusterID))
+	if err != nil {
+		return nil, err
+	}
+	current, _, err := hpdb.GetConfigurationWithContext(ctx, hpdb.NewGetConfigurationOptions(clusterID))
+	if err != nil {
+		return nil, err
+	}
+	return NewConfigurationRecommender().Recommend(cluster, current)
+}
+
+// recommendedValue is a recommended value with its rationale.
+type recommendedValue struct {
+	value     int64
+	rationale string
+}
+
+// sharedBuffers returns the recommended shared_buffers, in its unit, and false if it has no memory unit.
+func (recommender *ConfigurationRecommender) sharedBuffers(item *ConfigurationItem, memory Quantity) (recommendedValue, bool) {
+	size := memory.Mul(recommender.SharedBuffersRatio)
+	unit, ok := parameterUnitSize("shared_buffers", item.GetParameter("shared_buffers"))
+	if !ok {
+		return recommendedValue{}, false
+	}
+	return recommendedValue{
+		value:     int64(size) / int64(unit),
+		rationale: fmt.Sprintf("%s, %g%% of the %s of memory", size, recommender.SharedBuffersRatio*100, memory),
+	}, true
+}
+
+// maxConnections returns the recommended max_connections: the connections per CPU, limited by the memory left by
+// shared_buffers and by the plan.
+func (recommender *ConfigurationRecommender) maxConnections(cluster *Cluster, cpu int64, memory Quantity) recommendedValue {
+	connections := recommender.ConnectionsPerCpu * cpu
+	rationale := fmt.Sprintf("%d connections per CPU for %d CPUs", recommender.ConnectionsPerCpu, cpu)
+	if recommender.MemoryPerConnection > 0 {
+		available := memory.Sub(memory.Mul(recommender.SharedBuffersRatio))
+		if byMemory := int64(available / recommender.MemoryPerConnection); byMemory < connections {
+			connections = byMemory
+			rationale = fmt.Sprintf("one connection per %s of the %s of memory left by shared_buffers",
+				recommender.MemoryPerConnection, available)
+		}
+	}
+	if cluster.PlanID != nil {
+		if limit, ok := recommender.PlanMaxConnections[*cluster.PlanID]; ok && limit < connections {
+			connections = limit
+			rationale += fmt.Sprintf(", limited to %d by the plan %s", limit, *cluster.PlanID)
+		}
+	}
+	return recommendedValue{value: connections, rationale: rationale}
+}
+
+// maxLocksPerTransaction returns the recommended max_locks_per_transaction: the PostgreSQL default of 64, doubled for
+// clusters with 16GiB of memory or more and again with 64GiB or more, since they usually hold more tables and
+// partitions.
+func maxLocksPerTransaction(memory Quantity) recommendedValue {
+	switch {
+	case memory >= 64*GiB:
+		return recommendedValue{256, "256 for clusters with 64GiB of memory or more"}
+	case memory >= 16*GiB:
+		return recommendedValue{128, "128 for clusters with 16GiB of memory or more"}
+	}
+	return recommendedValue{64, "the PostgreSQL default for clusters with less than 16GiB of memory"}
+}
+
+// checkConfiguration returns the warnings for the current values which are far from the recommended ones.
+func checkConfiguration(item *ConfigurationItem, memory Quantity, sharedBuffers int64, maxConnections int64) []ConfigurationWarning {
+	var warnings []ConfigurationWarning
+	warn := func(name string, value ParameterValue, format string, args ...interface{}) {
+		warnings = append(warnings, ConfigurationWarning{Parameter: name, Value: value, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if parameter := item.GetParameter("max_connections"); parameter != nil {
+		if current, ok := parameter.Value.Int64(); ok && isFarOff(current, maxConnections) {
+			warn("max_connections", parameter.Value, "max_connections is %d, far from the recommended %d", current, maxConnections)
+		}
+	}
+	if parameter := item.GetParameter("shared_buffers"); parameter != nil {
+		current, ok := parameter.Value.Int64()
+		if unit, hasUnit := parameterUnitSize("shared_buffers", parameter); ok && hasUnit {
+			size := Quantity(current) * unit
+			switch {
+			case size >= memory:
+				warn("shared_buffers", parameter.Value, "shared_buffers is %s, more than the %s of memory of the cluster; the database server may not start", size, memory)
+			case isFarOff(current, sharedBuffers):
+				warn("shared_buffers", parameter.Value, "shared_buffers is %s, far from the recommended %s", size, Quantity(sharedBuffers)*unit)
+			}
+		}
+	}
+	// Each connection may use work_mem, several times for complex queries.
+	if workMem := item.GetParameter("work_mem"); workMem != nil && item.MaxConnections != nil && item.MaxConnections.Value != nil {
+		current, ok := workMem.Value.Int64()
+		unit, hasUnit := parameterUnitSize("work_mem", workMem)
+		connections := *item.MaxConnections.Value
+		size := Quantity(current) * unit
+		if ok && hasUnit && connections > 0 && size > memory/Quantity(connections) {
+			warn("work_mem", workMem.Value, "work_mem is %s, so that %d connections may use more than the %s of memory of the cluster", size, connections, memory)
+		}
+	}
+	return warnings
+}
+
+// isFarOff returns true if the current value is more than farOffFactor times smaller or larger than the recommended one.
+func isFarOff(current int64, recommended int64) bool {
+	return current*farOffFactor < recommended || current > recommended*farOffFactor
+}
+
+// parameterUnitSize returns the size of the memory unit of a parameter, as returned by parameterUnit, such as 8KiB for
+// a unit of 8kB, and false if the parameter has no memory unit.
+func parameterUnitSize(name string, parameter *Parameter) (Quantity, bool) {
+	if parameter == nil {
+		return 0, false
+	}
+	multiplier, units, base := splitConfUnit(parameterUnit(name, parameter))
+	if len(units) == 0 || units[0].name != memoryUnits[0].name {
+		return 0, false
+	}
+	return Quantity(multiplier * base.size), true
+}
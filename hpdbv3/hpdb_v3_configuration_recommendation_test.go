@@ -0,0 +1,181 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3_test
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3/hpdbv3test"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`HpdbV3 configuration recommendation`, func() {
+	clusterID := "9cebab98-afeb-4886-9a29-8e741716e7ff"
+	var cluster hpdbv3.Cluster
+	var current *hpdbv3.Configuration
+
+	BeforeEach(func() {
+		cluster = hpdbv3test.NewCluster(clusterID)
+		configuration := hpdbv3test.NewConfiguration()
+		current = &hpdbv3.Configuration{Configuration: &configuration}
+	})
+
+	Describe(`Recommend(cluster *Cluster, current *Configuration)`, func() {
+		It(`Invoke Recommend successfully`, func() {
+			recommendation, err := hpdbv3.NewConfigurationRecommender().Recommend(&cluster, current)
+			Expect(err).To(BeNil())
+			Expect(recommendation.ClusterID).To(Equal(clusterID))
+			Expect(recommendation.Recommendations).To(Equal([]hpdbv3.ParameterRecommendation{
+				{Name: "max_connections", Current: hpdbv3.IntegerValue(115), Recommended: hpdbv3.IntegerValue(100), Rationale: "50 connections per CPU for 2 CPUs"},
+				{Name: "max_locks_per_transaction", Current: hpdbv3.IntegerValue(64), Recommended: hpdbv3.IntegerValue(64), Rationale: "the PostgreSQL default for clusters with less than 16GiB of memory"},
+				{Name: "shared_buffers", Current: hpdbv3.IntegerValue(16384), Recommended: hpdbv3.IntegerValue(131072), Rationale: "1GiB, 25% of the 4GiB of memory"},
+			}))
+
+			// Only the changed parameters are to be updated
+			Expect(recommendation.IsEmpty()).To(BeFalse())
+			Expect(recommendation.Configurations.GetParameters()).To(Equal(map[string]hpdbv3.ParameterValue{
+				"max_connections": hpdbv3.IntegerValue(100),
+				"shared_buffers":  hpdbv3.IntegerValue(131072),
+			}))
+			_, err = hpdbv3.ValidateConfigurationChange(current, recommendation.Configurations)
+			Expect(err).To(BeNil())
+
+			Expect(recommendation.Warnings).To(Equal([]hpdbv3.ConfigurationWarning{
+				{Parameter: "shared_buffers", Value: hpdbv3.IntegerValue(16384), Message: "shared_buffers is 128MiB, far from the recommended 1GiB"},
+			}))
+			Expect(recommendation.String()).To(Equal("Configuration recommendation for cluster " + clusterID + ":\n" +
+				"  max_connections: 115 -> 100\n    50 connections per CPU for 2 CPUs\n" +
+				"  max_locks_per_transaction: 64 -> 64\n    the PostgreSQL default for clusters with less than 16GiB of memory\n" +
+				"  shared_buffers: 16384 -> 131072\n    1GiB, 25% of the 4GiB of memory\n" +
+				"  Warning: shared_buffers is 128MiB, far from the recommended 1GiB\n"))
+		})
+		It(`Invoke Recommend successfully after a downscale`, func() {
+			cluster.Resource.Cpu = core.Int64Ptr(16)
+			cluster.Resource.Memory = core.StringPtr("2GiB")
+			current.Configuration.SharedBuffers.Value = core.Int64Ptr(393216)
+			current.Configuration.MaxConnections.Value = core.Int64Ptr(1000)
+
+			recommendation, err := hpdbv3.NewConfigurationRecommender().Recommend(&cluster, current)
+			Expect(err).To(BeNil())
+			Expect(recommendation.Recommendations[0].Recommended).To(Equal(hpdbv3.IntegerValue(96)))
+			Expect(recommendation.Recommendations[0].Rationale).To(Equal("one connection per 16MiB of the 1536MiB of memory left by shared_buffers"))
+			Expect(recommendation.Recommendations[2].Recommended).To(Equal(hpdbv3.IntegerValue(65536)))
+
+			Expect(recommendation.Warnings).To(HaveLen(3))
+			Expect(recommendation.Warnings[0].Message).To(Equal("max_connections is 1000, far from the recommended 96"))
+			Expect(recommendation.Warnings[1].Message).To(Equal("shared_buffers is 3GiB, more than the 2GiB of memory of the cluster; the database server may not start"))
+			Expect(recommendation.Warnings[2].Parameter).To(Equal("work_mem"))
+		})
+		It(`Invoke Recommend successfully with limits`, func() {
+			cluster.Resource.Memory = core.StringPtr("128GiB")
+			cluster.Resource.Cpu = core.Int64Ptr(16)
+			current.Configuration.SharedBuffers.Max = core.Int64Ptr(2097152)
+			recommender := hpdbv3.NewConfigurationRecommender()
+			recommender.PlanMaxConnections = map[string]int64{"postgresql-flexible": 500}
+
+			recommendation, err := recommender.Recommend(&cluster, current)
+			Expect(err).To(BeNil())
+			Expect(recommendation.Recommendations[0].Recommended).To(Equal(hpdbv3.IntegerValue(500)))
+			Expect(recommendation.Recommendations[0].Rationale).To(Equal("50 connections per CPU for 16 CPUs, limited to 500 by the plan postgresql-flexible"))
+			Expect(recommendation.Recommendations[1].Recommended).To(Equal(hpdbv3.IntegerValue(256)))
+			Expect(recommendation.Recommendations[2].Recommended).To(Equal(hpdbv3.IntegerValue(2097152)))
+			Expect(recommendation.Recommendations[2].Rationale).To(Equal("32GiB, 25% of the 128GiB of memory, lowered to the maximum 2097152"))
+		})
+		It(`Invoke Recommend successfully with no changes`, func() {
+			current.Configuration.MaxConnections.Value = core.Int64Ptr(100)
+			current.Configuration.SharedBuffers.Value = core.Int64Ptr(131072)
+			recommendation, err := hpdbv3.NewConfigurationRecommender().Recommend(&cluster, current)
+			Expect(err).To(BeNil())
+			Expect(recommendation.IsEmpty()).To(BeTrue())
+			Expect(recommendation.Warnings).To(BeEmpty())
+		})
+		It(`Invoke Recommend successfully with units reported by the service`, func() {
+			// The reported units take precedence over ParameterUnits
+			reportUnits(current.Configuration, map[string]string{"shared_buffers": "16kB", "work_mem": "MB"})
+			recommendation, err := hpdbv3.NewConfigurationRecommender().Recommend(&cluster, current)
+			Expect(err).To(BeNil())
+			Expect(recommendation.Recommendations[2].Recommended).To(Equal(hpdbv3.IntegerValue(65536)))
+			Expect(recommendation.Warnings).To(HaveLen(1))
+			Expect(recommendation.Warnings[0].Message).To(Equal("work_mem is 4GiB, so that 115 connections may use more than the 4GiB of memory of the cluster"))
+
+			// shared_buffers and work_mem cannot be compared to the memory if their unit is not a memory unit
+			reportUnits(current.Configuration, map[string]string{"shared_buffers": "ms", "work_mem": "ms"})
+			recommendation, err = hpdbv3.NewConfigurationRecommender().Recommend(&cluster, current)
+			Expect(err).To(BeNil())
+			Expect(recommendation.Recommendations).To(HaveLen(2))
+			Expect(recommendation.Configurations.SharedBuffers).To(BeNil())
+			Expect(recommendation.Warnings).To(BeEmpty())
+		})
+		It(`Invoke Recommend with error: Unknown resources`, func() {
+			recommender := hpdbv3.NewConfigurationRecommender()
+			_, err := recommender.Recommend(&cluster, nil)
+			Expect(err).ToNot(BeNil())
+			cluster.Resource.Memory = core.StringPtr("4 bananas")
+			_, err = recommender.Recommend(&cluster, current)
+			Expect(err).ToNot(BeNil())
+			cluster.Resource = nil
+			_, err = recommender.Recommend(&cluster, current)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+	Describe(`RecommendConfiguration(ctx context.Context, clusterID string)`, func() {
+		It(`Invoke RecommendConfiguration successfully`, func() {
+			server := hpdbv3test.NewServer()
+			defer server.Close()
+			server.SetTaskDuration(0)
+			server.AddCluster(cluster)
+			hpdbService, err := server.NewClient()
+			Expect(err).To(BeNil())
+
+			// The fake server, like the service, does not report the units, so that those of ParameterUnits are used
+			recommendation, err := hpdbService.RecommendConfiguration(context.Background(), clusterID)
+			Expect(err).To(BeNil())
+			Expect(recommendation.Configurations.GetParameters()).To(HaveLen(2))
+			Expect(*recommendation.Configurations.SharedBuffers).To(Equal(int64(131072)))
+			Expect(recommendation.Warnings[0].Message).To(Equal("shared_buffers is 128MiB, far from the recommended 1GiB"))
+			_, _, err = hpdbService.UpdateConfiguration(hpdbService.NewUpdateConfigurationOptions(clusterID).SetConfiguration(recommendation.Configurations))
+			Expect(err).To(BeNil())
+
+			// shared_buffers is larger than the memory after a downscale
+			server.UpdateCluster(clusterID, func(cluster *hpdbv3.Cluster) {
+				cluster.Resource.Memory = core.StringPtr("512MiB")
+			})
+			recommendation, err = hpdbService.RecommendConfiguration(context.Background(), clusterID)
+			Expect(err).To(BeNil())
+			Expect(recommendation.Warnings).To(ContainElement(hpdbv3.ConfigurationWarning{
+				Parameter: "shared_buffers",
+				Value:     hpdbv3.IntegerValue(131072),
+				Message:   "shared_buffers is 1GiB, more than the 512MiB of memory of the cluster; the database server may not start",
+			}))
+
+			_, err = hpdbService.RecommendConfiguration(context.Background(), "no-such-cluster")
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
+
+// reportUnits sets the units of the parameters, as if the service reported them.
+func reportUnits(item *hpdbv3.ConfigurationItem, units map[string]string) {
+	for name, unit := range units {
+		parameter := item.GetParameter(name)
+		parameter.Unit = unit
+		item.SetParameter(name, parameter)
+	}
+}
@@ -0,0 +1,192 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ConfigurationValidationError : The error returned when a configuration parameter to be updated is not valid.
+type ConfigurationValidationError struct {
+	// The name of the parameter.
+	Parameter string
+
+	// The requested value.
+	Value string
+
+	// Why the value is not valid.
+	Reason string
+}
+
+// Error returns the error message.
+func (e *ConfigurationValidationError) Error() string {
+	return fmt.Sprintf("invalid %s %s: %s", e.Parameter, e.Value, e.Reason)
+}
+
+// ParameterChange : The change of the value of a configuration parameter.
+type ParameterChange struct {
+	// The name of the parameter.
+	Name string
+
+	// The current value.
+	From ParameterValue
+
+	// The new value.
+	To ParameterValue
+
+	// Whether the database server restarts when the parameter is changed.
+	RequiresRestart bool
+}
+
+// ConfigurationChanges : The changes of the parameters of a configuration, sorted by name.
+type ConfigurationChanges []ParameterChange
+
+// RequiresRestart returns true if one of the changes restarts the database server.
+func (changes ConfigurationChanges) RequiresRestart() bool {
+	return len(changes.RestartParameters()) > 0
+}
+
+// RestartParameters returns the names of the changed parameters which restart the database server.
+func (changes ConfigurationChanges) RestartParameters() []string {
+	var names []string
+	for _, change := range changes {
+		if change.RequiresRestart {
+			names = append(names, change.Name)
+		}
+	}
+	return names
+}
+
+// ValidateConfigurationChange checks the parameters to be updated by UpdateConfiguration against the current
+// configuration returned by GetConfiguration: the parameters must exist, and their values must have the type of the
+// parameter, be within its Min and Max, and be one of its allowed values for an enum. The error is a
+// *ConfigurationValidationError for the first invalid parameter by name.
+//
+// It returns the parameters whose value changes, with the ones which restart the database server.
+func ValidateConfigurationChange(current *Configuration, desired *Configurations) (changes ConfigurationChanges, err error) {
+	if current == nil || current.Configuration == nil {
+		return nil, fmt.Errorf("the current configuration is unknown")
+	}
+	values := desired.GetParameters()
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := values[name]
+		parameter := current.Configuration.GetParameter(name)
+		if parameter == nil {
+			return nil, &ConfigurationValidationError{Parameter: name, Value: value.String(), Reason: "the parameter is unknown"}
+		}
+		if reason := parameter.checkValue(value); reason != "" {
+			return nil, &ConfigurationValidationError{Parameter: name, Value: value.String(), Reason: reason}
+		}
+		if parameter.Value.equal(value) {
+			continue
+		}
+		changes = append(changes, ParameterChange{
+			Name:            name,
+			From:            parameter.Value,
+			To:              value,
+			RequiresRestart: parameter.RequiresRestart != nil && *parameter.RequiresRestart,
+		})
+	}
+	return
+}
+
+// checkValue returns why the value is not valid for the parameter, or "" if it is valid. The values of parameters of an
+// unknown type are not checked.
+func (parameter *Parameter) checkValue(value ParameterValue) string {
+	switch parameter.Type {
+	case ParameterTypeInteger:
+		i, ok := value.Int64()
+		if !ok {
+			return "the value must be an integer"
+		}
+		if parameter.Min != nil && i < *parameter.Min {
+			return fmt.Sprintf("the minimum is %d", *parameter.Min)
+		}
+		if parameter.Max != nil && i > *parameter.Max {
+			return fmt.Sprintf("the maximum is %d", *parameter.Max)
+		}
+	case ParameterTypeBool:
+		if _, ok := value.Bool(); !ok {
+			return "the value must be a boolean"
+		}
+	case ParameterTypeString:
+		if value.Kind() != ParameterTypeString {
+			return "the value must be a string"
+		}
+	case ParameterTypeEnum:
+		if value.Kind() != ParameterTypeString {
+			return "the value must be a string"
+		}
+		if len(parameter.AllowedValues) == 0 {
+			return ""
+		}
+		for _, allowed := range parameter.AllowedValues {
+			if strings.EqualFold(value.String(), allowed) {
+				return ""
+			}
+		}
+		return "allowed values are " + strings.Join(parameter.AllowedValues, ", ")
+	}
+	return ""
+}
+
+// equal returns true if two values are the same. Strings are compared ignoring case, as for enum values.
+func (v ParameterValue) equal(other ParameterValue) bool {
+	if v.kind == ParameterTypeString && other.kind == ParameterTypeString {
+		return strings.EqualFold(v.s, other.s)
+	}
+	return v == other
+}
+
+// EnableConfigurationValidation enables the validation of the parameters to be updated by UpdateConfiguration with
+// ValidateConfigurationChange, which gets the current configuration before every update. It is disabled by default.
+func (hpdb *HpdbV3) EnableConfigurationValidation() {
+	hpdb.validateConfiguration = true
+}
+
+// DisableConfigurationValidation disables the validation of the parameters to be updated by UpdateConfiguration, so
+// that they are sent to the service as is.
+func (hpdb *HpdbV3) DisableConfigurationValidation() {
+	hpdb.validateConfiguration = false
+}
+
+// validateUpdateConfiguration validates the parameters to be updated by UpdateConfiguration against the current
+// configuration, and the response is the one of GetConfiguration if that fails.
+func (hpdb *HpdbV3) validateUpdateConfiguration(ctx context.Context, updateConfigurationOptions *UpdateConfigurationOptions) (response *core.DetailedResponse, err error) {
+	if updateConfigurationOptions.Configuration == nil {
+		return
+	}
+	getConfigurationOptions := hpdb.NewGetConfigurationOptions(*updateConfigurationOptions.ClusterID)
+	getConfigurationOptions.SetHeaders(updateConfigurationOptions.Headers)
+	current, response, err := hpdb.GetConfigurationWithContext(ctx, getConfigurationOptions)
+	if err != nil {
+		return
+	}
+	_, err = ValidateConfigurationChange(current, updateConfigurationOptions.Configuration)
+	return nil, err
+}
@@ -0,0 +1,139 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3_test
+
+import (
+	"errors"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3/hpdbv3test"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`HpdbV3 configuration validation`, func() {
+	var current *hpdbv3.Configuration
+
+	BeforeEach(func() {
+		configuration := hpdbv3test.NewConfiguration()
+		current = &hpdbv3.Configuration{Configuration: &configuration}
+	})
+
+	Describe(`ValidateConfigurationChange(current *Configuration, desired *Configurations)`, func() {
+		It(`Invoke ValidateConfigurationChange successfully`, func() {
+			changes, err := hpdbv3.ValidateConfigurationChange(current, (&hpdbv3.Configurations{
+				MaxConnections:  core.Int64Ptr(200),
+				DeadlockTimeout: core.Int64Ptr(1000),
+			}).
+				SetParameter("work_mem", hpdbv3.IntegerValue(8192)).
+				SetParameter("log_min_messages", hpdbv3.StringValue("ERROR")))
+			Expect(err).To(BeNil())
+
+			// deadlock_timeout keeps its current value
+			Expect(changes).To(Equal(hpdbv3.ConfigurationChanges{
+				{Name: "log_min_messages", From: hpdbv3.StringValue("warning"), To: hpdbv3.StringValue("ERROR")},
+				{Name: "max_connections", From: hpdbv3.IntegerValue(115), To: hpdbv3.IntegerValue(200), RequiresRestart: true},
+				{Name: "work_mem", From: hpdbv3.IntegerValue(4096), To: hpdbv3.IntegerValue(8192)},
+			}))
+			Expect(changes.RequiresRestart()).To(BeTrue())
+			Expect(changes.RestartParameters()).To(Equal([]string{"max_connections"}))
+		})
+		It(`Invoke ValidateConfigurationChange successfully without restart`, func() {
+			changes, err := hpdbv3.ValidateConfigurationChange(current, (&hpdbv3.Configurations{}).SetParameter("log_connections", hpdbv3.BoolValue(true)))
+			Expect(err).To(BeNil())
+			Expect(changes).To(HaveLen(1))
+			Expect(changes.RequiresRestart()).To(BeFalse())
+			Expect(changes.RestartParameters()).To(BeEmpty())
+
+			changes, err = hpdbv3.ValidateConfigurationChange(current, nil)
+			Expect(err).To(BeNil())
+			Expect(changes).To(BeEmpty())
+		})
+		It(`Invoke ValidateConfigurationChange with error: Invalid values`, func() {
+			for desired, message := range map[*hpdbv3.Configurations]string{
+				{MaxConnections: core.Int64Ptr(0)}:                                                         "invalid max_connections 0: the minimum is 1",
+				{MaxConnections: core.Int64Ptr(1000000)}:                                                   "invalid max_connections 1000000: the maximum is 262143",
+				(&hpdbv3.Configurations{}).SetParameter("work_mem", hpdbv3.StringValue("8MB")):             "invalid work_mem 8MB: the value must be an integer",
+				(&hpdbv3.Configurations{}).SetParameter("log_connections", hpdbv3.StringValue("on")):       "invalid log_connections on: the value must be a boolean",
+				(&hpdbv3.Configurations{}).SetParameter("log_min_messages", hpdbv3.StringValue("verbose")): "invalid log_min_messages verbose: allowed values are debug5, debug4, debug3, debug2, debug1, info, notice, warning, error, log, fatal, panic",
+				(&hpdbv3.Configurations{}).SetParameter("no_such_parameter", hpdbv3.IntegerValue(1)):       "invalid no_such_parameter 1: the parameter is unknown",
+			} {
+				_, err := hpdbv3.ValidateConfigurationChange(current, desired)
+				var validationErr *hpdbv3.ConfigurationValidationError
+				Expect(errors.As(err, &validationErr)).To(BeTrue())
+				Expect(err.Error()).To(Equal(message))
+			}
+		})
+		It(`Invoke ValidateConfigurationChange with error: Unknown current configuration`, func() {
+			_, err := hpdbv3.ValidateConfigurationChange(nil, &hpdbv3.Configurations{MaxConnections: core.Int64Ptr(200)})
+			Expect(err).ToNot(BeNil())
+		})
+	})
+	Describe(`UpdateConfiguration(updateConfigurationOptions *UpdateConfigurationOptions) with validation`, func() {
+		var server *hpdbv3test.Server
+		var hpdbService *hpdbv3.HpdbV3
+		clusterID := "9cebab98-afeb-4886-9a29-8e741716e7ff"
+		configurationPath := "/clusters/" + clusterID + "/configuration"
+
+		BeforeEach(func() {
+			server = hpdbv3test.NewServer()
+			server.AddCluster(hpdbv3test.NewCluster(clusterID))
+			var err error
+			hpdbService, err = server.NewClient()
+			Expect(err).To(BeNil())
+		})
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It(`Invoke UpdateConfiguration successfully`, func() {
+			hpdbService.EnableConfigurationValidation()
+			options := hpdbService.NewUpdateConfigurationOptions(clusterID).SetConfiguration(&hpdbv3.Configurations{MaxConnections: core.Int64Ptr(200)})
+			taskID, _, err := hpdbService.UpdateConfiguration(options)
+			Expect(err).To(BeNil())
+			Expect(taskID).ToNot(BeNil())
+			Expect(server.Requests()).To(Equal([]string{"GET " + configurationPath, "PATCH " + configurationPath}))
+		})
+		It(`Invoke UpdateConfiguration with error: Invalid value`, func() {
+			hpdbService.EnableConfigurationValidation()
+			options := hpdbService.NewUpdateConfigurationOptions(clusterID).SetConfiguration(&hpdbv3.Configurations{MaxConnections: core.Int64Ptr(0)})
+			_, response, err := hpdbService.UpdateConfiguration(options)
+			Expect(err).To(BeAssignableToTypeOf(&hpdbv3.ConfigurationValidationError{}))
+			Expect(response).To(BeNil())
+			Expect(server.Requests()).To(Equal([]string{"GET " + configurationPath}))
+
+			// The invalid value is sent to the service when the validation is disabled
+			hpdbService.DisableConfigurationValidation()
+			_, response, err = hpdbService.UpdateConfiguration(options)
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(400))
+			Expect(server.Requests()).To(Equal([]string{"GET " + configurationPath, "PATCH " + configurationPath}))
+		})
+		It(`Invoke UpdateConfiguration with error: Configuration not supported`, func() {
+			cluster := hpdbv3test.NewCluster(clusterID)
+			cluster.DbType = core.StringPtr("mongodb")
+			server.AddCluster(cluster)
+			hpdbService.EnableConfigurationValidation()
+			options := hpdbService.NewUpdateConfigurationOptions(clusterID).SetConfiguration(&hpdbv3.Configurations{MaxConnections: core.Int64Ptr(200)})
+			_, response, err := hpdbService.UpdateConfiguration(options)
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(400))
+			Expect(server.Requests()).To(Equal([]string{"GET " + configurationPath}))
+		})
+	})
+})
@@ -0,0 +1,132 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// The service names of the database clusters, as found in their CRNs.
+const (
+	ServiceNamePostgreSQL = "hyperp-dbaas-postgresql"
+	ServiceNameMongoDB    = "hyperp-dbaas-mongodb"
+)
+
+// ClusterCRN : The parts of the CRN of a database cluster (service instance), such as
+// crn:v1:bluemix:public:hyperp-dbaas-postgresql:eu-de:a/e530ebd25f5ab6b0cf1e889593015f7a:e3dd2973-0e15-4fe3-8a26-f567a76e0b29::.
+type ClusterCRN struct {
+	// The name of the cloud, such as bluemix.
+	CloudName string
+
+	// The type of the cloud, such as public.
+	CloudType string
+
+	// The service name: hyperp-dbaas-postgresql or hyperp-dbaas-mongodb.
+	ServiceName string
+
+	// The region of the cluster, such as eu-de.
+	Region string
+
+	// The IBM Cloud account ID.
+	AccountID string
+
+	// The ID of the cluster.
+	ClusterID string
+}
+
+// ParseClusterCRN : Parse the CRN of a database cluster
+// The CRN must be the CRN of a PostgreSQL or MongoDB cluster, scoped to an account.
+func ParseClusterCRN(crn string) (clusterCRN *ClusterCRN, err error) {
+	// crn:version:cname:ctype:service-name:location:scope:service-instance:resource-type:resource
+	segments := strings.Split(crn, ":")
+	if len(segments) != 10 || segments[0] != "crn" || segments[1] != "v1" {
+		err = fmt.Errorf("invalid CRN %q: it must have the form crn:v1:{cname}:{ctype}:{service-name}:{region}:a/{account_id}:{cluster_id}::", crn)
+		return
+	}
+	if segments[4] != ServiceNamePostgreSQL && segments[4] != ServiceNameMongoDB {
+		err = fmt.Errorf("invalid CRN %q: the service name %q is not %s or %s", crn, segments[4], ServiceNamePostgreSQL, ServiceNameMongoDB)
+		return
+	}
+	if !strings.HasPrefix(segments[6], "a/") || segments[6] == "a/" {
+		err = fmt.Errorf("invalid CRN %q: the scope %q is not an account", crn, segments[6])
+		return
+	}
+	if segments[5] == "" || segments[7] == "" {
+		err = fmt.Errorf("invalid CRN %q: the region and the cluster ID are required", crn)
+		return
+	}
+
+	clusterCRN = &ClusterCRN{
+		CloudName:   segments[2],
+		CloudType:   segments[3],
+		ServiceName: segments[4],
+		Region:      segments[5],
+		AccountID:   strings.TrimPrefix(segments[6], "a/"),
+		ClusterID:   segments[7],
+	}
+	return
+}
+
+// DbType returns the type of the database cluster: postgresql or mongodb.
+func (clusterCRN *ClusterCRN) DbType() string {
+	return strings.TrimPrefix(clusterCRN.ServiceName, "hyperp-dbaas-")
+}
+
+// String returns the CRN.
+func (clusterCRN *ClusterCRN) String() string {
+	return fmt.Sprintf("crn:v1:%s:%s:%s:%s:a/%s:%s::", clusterCRN.CloudName, clusterCRN.CloudType, clusterCRN.ServiceName,
+		clusterCRN.Region, clusterCRN.AccountID, clusterCRN.ClusterID)
+}
+
+// ServiceURL returns the service URL of the account of the cluster at the specified endpoint, such as
+// dbaas902.hyperp-dbaas.cloud.ibm.com:20000. An empty endpoint selects the public endpoint of the region of the
+// cluster.
+func (clusterCRN *ClusterCRN) ServiceURL(endpoint string) (string, error) {
+	endpoint = normalizeEndpoint(endpoint)
+	if endpoint == "" {
+		endpoints, err := GetEndpointsForRegion(clusterCRN.Region)
+		if err != nil {
+			return "", err
+		}
+		endpoint = endpoints.Public
+	}
+	return ConstructServiceURL(map[string]string{
+		"endpoint":   endpoint,
+		"account_id": clusterCRN.AccountID,
+	})
+}
+
+// NewHpdbV3FromCRN : constructs an instance of HpdbV3 for the account of a database cluster.
+// The service URL is derived from the endpoint, such as dbaas902.hyperp-dbaas.cloud.ibm.com:20000, and the account ID
+// in the CRN of the cluster. An empty endpoint selects the public endpoint of the region of the cluster.
+func NewHpdbV3FromCRN(crn string, endpoint string, authenticator core.Authenticator) (service *HpdbV3, err error) {
+	clusterCRN, err := ParseClusterCRN(crn)
+	if err != nil {
+		return
+	}
+	serviceURL, err := clusterCRN.ServiceURL(endpoint)
+	if err != nil {
+		return
+	}
+	return NewHpdbV3(&HpdbV3Options{
+		URL:           serviceURL,
+		Authenticator: authenticator,
+	})
+}
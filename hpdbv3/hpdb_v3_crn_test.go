@@ -0,0 +1,103 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3_test
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`HpdbV3 CRN`, func() {
+	postgresqlCRN := "crn:v1:bluemix:public:hyperp-dbaas-postgresql:eu-de:a/e530ebd25f5ab6b0cf1e889593015f7a:e3dd2973-0e15-4fe3-8a26-f567a76e0b29::"
+
+	Describe(`ParseClusterCRN(crn string)`, func() {
+		It(`Invoke ParseClusterCRN successfully`, func() {
+			clusterCRN, err := hpdbv3.ParseClusterCRN(postgresqlCRN)
+			Expect(err).To(BeNil())
+			Expect(clusterCRN).To(Equal(&hpdbv3.ClusterCRN{
+				CloudName:   "bluemix",
+				CloudType:   "public",
+				ServiceName: hpdbv3.ServiceNamePostgreSQL,
+				Region:      "eu-de",
+				AccountID:   "e530ebd25f5ab6b0cf1e889593015f7a",
+				ClusterID:   "e3dd2973-0e15-4fe3-8a26-f567a76e0b29",
+			}))
+			Expect(clusterCRN.DbType()).To(Equal("postgresql"))
+			Expect(clusterCRN.String()).To(Equal(postgresqlCRN))
+
+			clusterCRN, err = hpdbv3.ParseClusterCRN("crn:v1:bluemix:public:hyperp-dbaas-mongodb:us-south:a/23a24a3e3fe7a115473f07be1c44bdb5:9cebab98-afeb-4886-9a29-8e741716e7ff::")
+			Expect(err).To(BeNil())
+			Expect(clusterCRN.DbType()).To(Equal("mongodb"))
+			Expect(clusterCRN.Region).To(Equal("us-south"))
+		})
+		It(`Invoke ParseClusterCRN with error: Invalid CRN`, func() {
+			for _, crn := range []string{
+				"",
+				"e3dd2973-0e15-4fe3-8a26-f567a76e0b29",
+				"crn:v1:bluemix:public:hyperp-dbaas-postgresql:eu-de:a/e530ebd25f5ab6b0cf1e889593015f7a",
+				"crn:v1:bluemix:public:cloud-object-storage:global:a/e530ebd25f5ab6b0cf1e889593015f7a:e3dd2973-0e15-4fe3-8a26-f567a76e0b29::",
+				"crn:v1:bluemix:public:hyperp-dbaas-postgresql:eu-de:o/e530ebd25f5ab6b0cf1e889593015f7a:e3dd2973-0e15-4fe3-8a26-f567a76e0b29::",
+				"crn:v1:bluemix:public:hyperp-dbaas-postgresql:eu-de:a/e530ebd25f5ab6b0cf1e889593015f7a:::",
+			} {
+				clusterCRN, err := hpdbv3.ParseClusterCRN(crn)
+				Expect(err).ToNot(BeNil(), crn)
+				Expect(clusterCRN).To(BeNil())
+			}
+		})
+	})
+	Describe(`NewHpdbV3FromCRN(crn string, endpoint string, authenticator core.Authenticator)`, func() {
+		It(`Invoke NewHpdbV3FromCRN successfully`, func() {
+			hpdbService, err := hpdbv3.NewHpdbV3FromCRN(postgresqlCRN, "dbaas902.hyperp-dbaas.cloud.ibm.com:20000", &core.NoAuthAuthenticator{})
+			Expect(err).To(BeNil())
+			Expect(hpdbService.GetServiceURL()).To(Equal("https://dbaas902.hyperp-dbaas.cloud.ibm.com:20000/api/v3/e530ebd25f5ab6b0cf1e889593015f7a"))
+
+			hpdbService, err = hpdbv3.NewHpdbV3FromCRN(postgresqlCRN, "https://dbaas902.hyperp-dbaas.cloud.ibm.com:20000/", &core.NoAuthAuthenticator{})
+			Expect(err).To(BeNil())
+			Expect(hpdbService.GetServiceURL()).To(Equal("https://dbaas902.hyperp-dbaas.cloud.ibm.com:20000/api/v3/e530ebd25f5ab6b0cf1e889593015f7a"))
+		})
+		It(`Invoke NewHpdbV3FromCRN successfully with the endpoint of the region`, func() {
+			hpdbService, err := hpdbv3.NewHpdbV3FromCRN(postgresqlCRN, "", &core.NoAuthAuthenticator{})
+			Expect(err).To(BeNil())
+			Expect(hpdbService.GetServiceURL()).To(Equal("https://dbaas902.hyperp-dbaas.cloud.ibm.com:20000/api/v3/e530ebd25f5ab6b0cf1e889593015f7a"))
+		})
+		It(`Invoke NewHpdbV3FromCRN with error: Invalid arguments`, func() {
+			hpdbService, err := hpdbv3.NewHpdbV3FromCRN("invalid", "dbaas902.hyperp-dbaas.cloud.ibm.com:20000", &core.NoAuthAuthenticator{})
+			Expect(err).ToNot(BeNil())
+			Expect(hpdbService).To(BeNil())
+
+			hpdbService, err = hpdbv3.NewHpdbV3FromCRN("crn:v1:bluemix:public:hyperp-dbaas-postgresql:mars-north:a/e530ebd25f5ab6b0cf1e889593015f7a:e3dd2973-0e15-4fe3-8a26-f567a76e0b29::", "", &core.NoAuthAuthenticator{})
+			Expect(err).ToNot(BeNil())
+			Expect(hpdbService).To(BeNil())
+
+			hpdbService, err = hpdbv3.NewHpdbV3FromCRN(postgresqlCRN, "dbaas902.hyperp-dbaas.cloud.ibm.com:20000", nil)
+			Expect(err).ToNot(BeNil())
+			Expect(hpdbService).To(BeNil())
+		})
+	})
+	Describe(`ConstructServiceURL(providedUrlVariables map[string]string)`, func() {
+		It(`Format parameterized URL with the endpoint and the account ID`, func() {
+			constructedURL, err := hpdbv3.ConstructServiceURL(map[string]string{
+				"endpoint":   "dbaas901.hyperp-dbaas.cloud.ibm.com:20000",
+				"account_id": "e530ebd25f5ab6b0cf1e889593015f7a",
+			})
+			Expect(err).To(BeNil())
+			Expect(constructedURL).To(Equal("https://dbaas901.hyperp-dbaas.cloud.ibm.com:20000/api/v3/e530ebd25f5ab6b0cf1e889593015f7a"))
+		})
+	})
+})
@@ -0,0 +1,353 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3
+
+import (
+	"strings"
+)
+
+// The enumerated types below are parsed case-insensitively. A value which is not one of the known constants keeps its
+// raw string, so that values added by newer versions of the service are not lost; IsKnown reports whether the value is
+// one of the constants.
+
+// ClusterState : The state of a database cluster.
+type ClusterState string
+
+// Constants associated with the Cluster.State property.
+const (
+	ClusterStateRunning ClusterState = "RUNNING"
+	ClusterStateStopped ClusterState = "STOPPED"
+	ClusterStateFailed  ClusterState = "FAILED"
+)
+
+var clusterStates = []string{string(ClusterStateRunning), string(ClusterStateStopped), string(ClusterStateFailed)}
+
+// ParseClusterState returns the ClusterState of a value of Cluster.State.
+func ParseClusterState(value string) ClusterState {
+	return ClusterState(parseEnum(value, clusterStates))
+}
+
+// IsKnown returns true if the state is one of the ClusterState constants.
+func (state ClusterState) IsKnown() bool {
+	return isKnownEnum(string(state), clusterStates)
+}
+
+// IsFailed returns true if the cluster is in the FAILED state.
+func (state ClusterState) IsFailed() bool {
+	return state == ClusterStateFailed
+}
+
+// NodeState : The state of a node of a database cluster.
+type NodeState string
+
+// Constants associated with the Node.NodeState property.
+const (
+	NodeStateRunning NodeState = "RUNNING"
+	NodeStateStopped NodeState = "STOPPED"
+	NodeStateDeleted NodeState = "DELETED"
+)
+
+var nodeStates = []string{string(NodeStateRunning), string(NodeStateStopped), string(NodeStateDeleted)}
+
+// ParseNodeState returns the NodeState of a value of Node.NodeState.
+func ParseNodeState(value string) NodeState {
+	return NodeState(parseEnum(value, nodeStates))
+}
+
+// IsKnown returns true if the state is one of the NodeState constants.
+func (state NodeState) IsKnown() bool {
+	return isKnownEnum(string(state), nodeStates)
+}
+
+// ReplicaState : The replication role of a node of a database cluster.
+type ReplicaState string
+
+// Constants associated with the Node.ReplicaState property.
+const (
+	ReplicaStatePrimary   ReplicaState = "PRIMARY"
+	ReplicaStateSecondary ReplicaState = "SECONDARY"
+)
+
+var replicaStates = []string{string(ReplicaStatePrimary), string(ReplicaStateSecondary)}
+
+// ParseReplicaState returns the ReplicaState of a value of Node.ReplicaState.
+func ParseReplicaState(value string) ReplicaState {
+	return ReplicaState(parseEnum(value, replicaStates))
+}
+
+// IsKnown returns true if the state is one of the ReplicaState constants.
+func (state ReplicaState) IsKnown() bool {
+	return isKnownEnum(string(state), replicaStates)
+}
+
+// IsPrimary returns true if the node is the primary node of the cluster.
+func (state ReplicaState) IsPrimary() bool {
+	return state == ReplicaStatePrimary
+}
+
+// StoppedReason : The reason why a node of a database cluster was stopped.
+type StoppedReason string
+
+// Constants associated with the Node.StoppedReason property.
+const (
+	// The node was not stopped.
+	StoppedReasonNone StoppedReason = ""
+
+	// The node was stopped because the external key was deleted.
+	StoppedReasonExternalKeyDeleted StoppedReason = "EXTERNAL_KEY_DELETED"
+
+	// The node was stopped because of the external key with unknown reason.
+	StoppedReasonExternalKeyUnknown StoppedReason = "EXTERNAL_KEY_UNKNOWN"
+
+	// The node was stopped with unknown reason.
+	StoppedReasonUnknown StoppedReason = "UNKNOWN"
+)
+
+var stoppedReasons = []string{
+	string(StoppedReasonNone),
+	string(StoppedReasonExternalKeyDeleted),
+	string(StoppedReasonExternalKeyUnknown),
+	string(StoppedReasonUnknown),
+}
+
+// ParseStoppedReason returns the StoppedReason of a value of Node.StoppedReason.
+func ParseStoppedReason(value string) StoppedReason {
+	return StoppedReason(parseEnum(value, stoppedReasons))
+}
+
+// IsKnown returns true if the reason is one of the StoppedReason constants.
+func (reason StoppedReason) IsKnown() bool {
+	return isKnownEnum(string(reason), stoppedReasons)
+}
+
+// IsStopped returns true if the node was stopped.
+func (reason StoppedReason) IsStopped() bool {
+	return reason != StoppedReasonNone
+}
+
+// IsStoppedByExternalKey returns true if the node was stopped because of its external key.
+func (reason StoppedReason) IsStoppedByExternalKey() bool {
+	return reason == StoppedReasonExternalKeyDeleted || reason == StoppedReasonExternalKeyUnknown
+}
+
+// TaskState : The state of a task, or of a task on a node.
+type TaskState string
+
+// Constants associated with the Task.State, TaskItem.State and TaskNode.State properties.
+const (
+	TaskStateRunning   TaskState = "RUNNING"
+	TaskStateSucceeded TaskState = "SUCCEEDED"
+	TaskStateFailed    TaskState = "FAILED"
+)
+
+var taskStates = []string{string(TaskStateRunning), string(TaskStateSucceeded), string(TaskStateFailed)}
+
+// ParseTaskState returns the TaskState of a value of Task.State, TaskItem.State or TaskNode.State.
+func ParseTaskState(value string) TaskState {
+	return TaskState(parseEnum(value, taskStates))
+}
+
+// IsKnown returns true if the state is one of the TaskState constants.
+func (state TaskState) IsKnown() bool {
+	return isKnownEnum(string(state), taskStates)
+}
+
+// IsTerminal returns true if the task has finished, that is if it is in the SUCCEEDED or FAILED state.
+func (state TaskState) IsTerminal() bool {
+	return state == TaskStateSucceeded || state == TaskStateFailed
+}
+
+// TaskType : The type of a task.
+type TaskType string
+
+// Constants associated with the Task.Type and TaskItem.Type properties.
+const (
+	TaskTypeScaleResources      TaskType = "scale_resources"
+	TaskTypeUpdateConfiguration TaskType = "update_configuration"
+	TaskTypeEnableCosBackup     TaskType = "enable_cos_backup"
+	TaskTypeDisableCosBackup    TaskType = "disable_cos_backup"
+	TaskTypeUpdateBackupConfig  TaskType = "update_backup_config"
+	TaskTypeRestore             TaskType = "restore"
+)
+
+var taskTypes = []string{
+	string(TaskTypeScaleResources),
+	string(TaskTypeUpdateConfiguration),
+	string(TaskTypeEnableCosBackup),
+	string(TaskTypeDisableCosBackup),
+	string(TaskTypeUpdateBackupConfig),
+	string(TaskTypeRestore),
+}
+
+// ParseTaskType returns the TaskType of a value of Task.Type or TaskItem.Type.
+func ParseTaskType(value string) TaskType {
+	return TaskType(parseEnum(value, taskTypes))
+}
+
+// IsKnown returns true if the type is one of the TaskType constants.
+func (taskType TaskType) IsKnown() bool {
+	return isKnownEnum(string(taskType), taskTypes)
+}
+
+// RestoreSourceType : The type of the backup source of a restore.
+type RestoreSourceType string
+
+// Constants associated with the RestoreOptions.SourceType property.
+const (
+	RestoreSourceTypeCos     RestoreSourceType = "cos"
+	RestoreSourceTypeDefault RestoreSourceType = "default"
+)
+
+var restoreSourceTypes = []string{string(RestoreSourceTypeCos), string(RestoreSourceTypeDefault)}
+
+// ParseRestoreSourceType returns the RestoreSourceType of a value of RestoreOptions.SourceType.
+func ParseRestoreSourceType(value string) RestoreSourceType {
+	return RestoreSourceType(parseEnum(value, restoreSourceTypes))
+}
+
+// IsKnown returns true if the type is one of the RestoreSourceType constants.
+func (sourceType RestoreSourceType) IsKnown() bool {
+	return isKnownEnum(string(sourceType), restoreSourceTypes)
+}
+
+// BackupScheduleType : The type of a backup schedule.
+type BackupScheduleType string
+
+// Constants associated with the BackupSchedule.Type property.
+const (
+	BackupScheduleTypeFrequency BackupScheduleType = "frequency"
+)
+
+var backupScheduleTypes = []string{string(BackupScheduleTypeFrequency)}
+
+// ParseBackupScheduleType returns the BackupScheduleType of a value of BackupSchedule.Type.
+func ParseBackupScheduleType(value string) BackupScheduleType {
+	return BackupScheduleType(parseEnum(value, backupScheduleTypes))
+}
+
+// IsKnown returns true if the type is one of the BackupScheduleType constants.
+func (scheduleType BackupScheduleType) IsKnown() bool {
+	return isKnownEnum(string(scheduleType), backupScheduleTypes)
+}
+
+// parseEnum returns the known value which is equal to value under case folding, or value itself if there is none.
+func parseEnum(value string, known []string) string {
+	for _, k := range known {
+		if strings.EqualFold(value, k) {
+			return k
+		}
+	}
+	return value
+}
+
+// isKnownEnum returns true if value is one of the known values.
+func isKnownEnum(value string, known []string) bool {
+	for _, k := range known {
+		if value == k {
+			return true
+		}
+	}
+	return false
+}
+
+// GetState returns the state of the cluster, or "" if it is not set.
+func (cluster *Cluster) GetState() ClusterState {
+	if cluster == nil || cluster.State == nil {
+		return ""
+	}
+	return ParseClusterState(*cluster.State)
+}
+
+// GetNodeState returns the state of the node, or "" if it is not set.
+func (node *Node) GetNodeState() NodeState {
+	if node == nil || node.NodeState == nil {
+		return ""
+	}
+	return ParseNodeState(*node.NodeState)
+}
+
+// GetReplicaState returns the replication role of the node, or "" if it is not set.
+func (node *Node) GetReplicaState() ReplicaState {
+	if node == nil || node.ReplicaState == nil {
+		return ""
+	}
+	return ParseReplicaState(*node.ReplicaState)
+}
+
+// GetStoppedReason returns the reason why the node was stopped, or StoppedReasonNone if it was not stopped.
+func (node *Node) GetStoppedReason() StoppedReason {
+	if node == nil || node.StoppedReason == nil {
+		return StoppedReasonNone
+	}
+	return ParseStoppedReason(*node.StoppedReason)
+}
+
+// GetState returns the state of the task, or "" if it is not set.
+func (task *Task) GetState() TaskState {
+	if task == nil || task.State == nil {
+		return ""
+	}
+	return ParseTaskState(*task.State)
+}
+
+// GetType returns the type of the task, or "" if it is not set.
+func (task *Task) GetType() TaskType {
+	if task == nil || task.Type == nil {
+		return ""
+	}
+	return ParseTaskType(*task.Type)
+}
+
+// GetState returns the state of the task, or "" if it is not set.
+func (taskItem *TaskItem) GetState() TaskState {
+	if taskItem == nil || taskItem.State == nil {
+		return ""
+	}
+	return ParseTaskState(*taskItem.State)
+}
+
+// GetType returns the type of the task, or "" if it is not set.
+func (taskItem *TaskItem) GetType() TaskType {
+	if taskItem == nil || taskItem.Type == nil {
+		return ""
+	}
+	return ParseTaskType(*taskItem.Type)
+}
+
+// GetState returns the state of the task on the node, or "" if it is not set.
+func (taskNode *TaskNode) GetState() TaskState {
+	if taskNode == nil || taskNode.State == nil {
+		return ""
+	}
+	return ParseTaskState(*taskNode.State)
+}
+
+// GetSourceType returns the type of the backup source, or "" if it is not set.
+func (_options *RestoreOptions) GetSourceType() RestoreSourceType {
+	if _options == nil || _options.SourceType == nil {
+		return ""
+	}
+	return ParseRestoreSourceType(*_options.SourceType)
+}
+
+// GetType returns the type of the backup schedule, or "" if it is not set.
+func (schedule *BackupSchedule) GetType() BackupScheduleType {
+	if schedule == nil || schedule.Type == nil {
+		return ""
+	}
+	return ParseBackupScheduleType(*schedule.Type)
+}
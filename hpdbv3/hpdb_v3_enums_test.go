@@ -0,0 +1,118 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3_test
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`HpdbV3 enums`, func() {
+	Describe(`ParseTaskState(value string)`, func() {
+		It(`Invoke ParseTaskState successfully`, func() {
+			Expect(hpdbv3.ParseTaskState("SUCCEEDED")).To(Equal(hpdbv3.TaskStateSucceeded))
+			Expect(hpdbv3.ParseTaskState("failed")).To(Equal(hpdbv3.TaskStateFailed))
+			Expect(hpdbv3.ParseTaskState("Running")).To(Equal(hpdbv3.TaskStateRunning))
+
+			Expect(hpdbv3.TaskStateSucceeded.IsTerminal()).To(BeTrue())
+			Expect(hpdbv3.TaskStateFailed.IsTerminal()).To(BeTrue())
+			Expect(hpdbv3.TaskStateRunning.IsTerminal()).To(BeFalse())
+		})
+		It(`Invoke ParseTaskState successfully with an unknown value`, func() {
+			state := hpdbv3.ParseTaskState("Cancelled")
+			Expect(string(state)).To(Equal("Cancelled"))
+			Expect(state.IsKnown()).To(BeFalse())
+			Expect(state.IsTerminal()).To(BeFalse())
+			Expect(hpdbv3.TaskStateRunning.IsKnown()).To(BeTrue())
+		})
+	})
+	Describe(`ParseReplicaState(value string)`, func() {
+		It(`Invoke ParseReplicaState successfully`, func() {
+			Expect(hpdbv3.ParseReplicaState("primary").IsPrimary()).To(BeTrue())
+			Expect(hpdbv3.ParseReplicaState("SECONDARY")).To(Equal(hpdbv3.ReplicaStateSecondary))
+			Expect(hpdbv3.ParseReplicaState("SECONDARY").IsPrimary()).To(BeFalse())
+			Expect(hpdbv3.ParseReplicaState("ARBITER").IsKnown()).To(BeFalse())
+		})
+	})
+	Describe(`ParseStoppedReason(value string)`, func() {
+		It(`Invoke ParseStoppedReason successfully`, func() {
+			Expect(hpdbv3.ParseStoppedReason("")).To(Equal(hpdbv3.StoppedReasonNone))
+			Expect(hpdbv3.StoppedReasonNone.IsKnown()).To(BeTrue())
+			Expect(hpdbv3.StoppedReasonNone.IsStopped()).To(BeFalse())
+			Expect(hpdbv3.StoppedReasonNone.IsStoppedByExternalKey()).To(BeFalse())
+
+			Expect(hpdbv3.ParseStoppedReason("external_key_deleted").IsStoppedByExternalKey()).To(BeTrue())
+			Expect(hpdbv3.ParseStoppedReason("EXTERNAL_KEY_UNKNOWN").IsStoppedByExternalKey()).To(BeTrue())
+
+			reason := hpdbv3.ParseStoppedReason("UNKNOWN")
+			Expect(reason).To(Equal(hpdbv3.StoppedReasonUnknown))
+			Expect(reason.IsKnown()).To(BeTrue())
+			Expect(reason.IsStopped()).To(BeTrue())
+			Expect(reason.IsStoppedByExternalKey()).To(BeFalse())
+		})
+	})
+	Describe(`Enum accessors`, func() {
+		It(`Invoke the accessors of Cluster and Node successfully`, func() {
+			cluster := &hpdbv3.Cluster{
+				State: core.StringPtr("failed"),
+				Nodes: []hpdbv3.Node{{
+					ReplicaState:  core.StringPtr("PRIMARY"),
+					NodeState:     core.StringPtr("STOPPED"),
+					StoppedReason: core.StringPtr("EXTERNAL_KEY_DELETED"),
+				}},
+			}
+			Expect(cluster.GetState()).To(Equal(hpdbv3.ClusterStateFailed))
+			Expect(cluster.GetState().IsFailed()).To(BeTrue())
+			node := &cluster.Nodes[0]
+			Expect(node.GetReplicaState().IsPrimary()).To(BeTrue())
+			Expect(node.GetNodeState()).To(Equal(hpdbv3.NodeStateStopped))
+			Expect(node.GetStoppedReason().IsStoppedByExternalKey()).To(BeTrue())
+		})
+		It(`Invoke the accessors of Task, TaskItem, RestoreOptions and BackupSchedule successfully`, func() {
+			task := &hpdbv3.Task{Type: core.StringPtr("restore"), State: core.StringPtr("SUCCEEDED"), Nodes: []hpdbv3.TaskNode{{State: core.StringPtr("FAILED")}}}
+			Expect(task.GetType()).To(Equal(hpdbv3.TaskTypeRestore))
+			Expect(task.GetState().IsTerminal()).To(BeTrue())
+			Expect(task.Nodes[0].GetState()).To(Equal(hpdbv3.TaskStateFailed))
+
+			taskItem := &hpdbv3.TaskItem{Type: core.StringPtr("rebalance"), State: core.StringPtr("RUNNING")}
+			Expect(string(taskItem.GetType())).To(Equal("rebalance"))
+			Expect(taskItem.GetType().IsKnown()).To(BeFalse())
+			Expect(taskItem.GetState()).To(Equal(hpdbv3.TaskStateRunning))
+
+			restoreOptions := new(hpdbv3.RestoreOptions).SetSourceType("COS")
+			Expect(restoreOptions.GetSourceType()).To(Equal(hpdbv3.RestoreSourceTypeCos))
+
+			schedule := &hpdbv3.BackupSchedule{Type: core.StringPtr("Frequency")}
+			Expect(schedule.GetType()).To(Equal(hpdbv3.BackupScheduleTypeFrequency))
+			Expect(schedule.GetType().IsKnown()).To(BeTrue())
+		})
+		It(`Invoke the accessors successfully with unset values`, func() {
+			var cluster *hpdbv3.Cluster
+			Expect(cluster.GetState()).To(BeEmpty())
+			Expect(new(hpdbv3.Node).GetReplicaState().IsPrimary()).To(BeFalse())
+			Expect(new(hpdbv3.Node).GetNodeState()).To(BeEmpty())
+			Expect(new(hpdbv3.Node).GetStoppedReason()).To(Equal(hpdbv3.StoppedReasonNone))
+			Expect(new(hpdbv3.Task).GetState().IsTerminal()).To(BeFalse())
+			Expect(new(hpdbv3.Task).GetType()).To(BeEmpty())
+			Expect(new(hpdbv3.TaskItem).GetState()).To(BeEmpty())
+			Expect(new(hpdbv3.RestoreOptions).GetSourceType()).To(BeEmpty())
+			Expect(new(hpdbv3.BackupSchedule).GetType()).To(BeEmpty())
+		})
+	})
+})
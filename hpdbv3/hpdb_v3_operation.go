@@ -0,0 +1,345 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Operation : A handle to a long-running change on a cluster that is tracked by a task.
+// Operations are returned by the ...Async and ...AndWait forms of the methods which return a TaskID, and can also be
+// created for an existing task with NewOperation. An Operation may be used from multiple goroutines.
+type Operation struct {
+	hpdb        *HpdbV3
+	clusterID   string
+	taskID      string
+	waitOptions *WaitForTaskOptions
+
+	mu             sync.Mutex
+	onNodeProgress []func(node TaskNode)
+	nodeStates     map[string]string
+	task           *Task
+	err            error
+	done           chan struct{}
+}
+
+// NewOperation : Instantiate an Operation for the task that is indicated by its ID
+func (hpdb *HpdbV3) NewOperation(clusterID string, taskID string) *Operation {
+	return &Operation{
+		hpdb:       hpdb,
+		clusterID:  clusterID,
+		taskID:     taskID,
+		nodeStates: make(map[string]string),
+		done:       make(chan struct{}),
+	}
+}
+
+// newOperationFromTaskID returns an Operation for the task ID returned by an operation on the specified cluster.
+func (hpdb *HpdbV3) newOperationFromTaskID(clusterID string, taskID *TaskID) (*Operation, error) {
+	if taskID == nil || core.IsNil(taskID.TaskID) {
+		return nil, fmt.Errorf("the response does not contain a task ID")
+	}
+	return hpdb.NewOperation(clusterID, *taskID.TaskID), nil
+}
+
+// SetWaitOptions : Allow user to set the options used by Await to poll the task
+func (op *Operation) SetWaitOptions(waitForTaskOptions *WaitForTaskOptions) *Operation {
+	op.mu.Lock()
+	defer op.mu.Unlock()
+	op.waitOptions = waitForTaskOptions
+	return op
+}
+
+// OnNodeProgress : Register a callback which is invoked whenever Poll observes a new state of the task on a node
+func (op *Operation) OnNodeProgress(callback func(node TaskNode)) *Operation {
+	op.mu.Lock()
+	defer op.mu.Unlock()
+	op.onNodeProgress = append(op.onNodeProgress, callback)
+	return op
+}
+
+// ID returns the ID of the task which tracks the operation.
+func (op *Operation) ID() string {
+	return op.taskID
+}
+
+// ClusterID returns the ID of the cluster that the operation runs on.
+func (op *Operation) ClusterID() string {
+	return op.clusterID
+}
+
+// Done returns a channel that is closed once Poll or Await has observed the task in the SUCCEEDED or FAILED state.
+func (op *Operation) Done() <-chan struct{} {
+	return op.done
+}
+
+// Result returns the final task and error of a finished operation. Nil values are returned while the operation has
+// not finished.
+func (op *Operation) Result() (*Task, error) {
+	op.mu.Lock()
+	defer op.mu.Unlock()
+	select {
+	case <-op.done:
+		return op.task, op.err
+	default:
+		return nil, nil
+	}
+}
+
+// Poll retrieves the current state of the task once. If the task has finished, the final task is returned, together
+// with a *TaskFailedError if it ended in the FAILED state; later calls return the same result without calling the
+// service again.
+func (op *Operation) Poll(ctx context.Context) (result *Task, err error) {
+	select {
+	case <-op.done:
+		return op.Result()
+	default:
+	}
+
+	getTaskOptions := op.hpdb.NewGetTaskOptions(op.clusterID, op.taskID)
+	op.mu.Lock()
+	if op.waitOptions != nil {
+		getTaskOptions.SetHeaders(op.waitOptions.Headers)
+	}
+	op.mu.Unlock()
+
+	result, _, err = op.hpdb.GetTaskWithContext(ctx, getTaskOptions)
+	if err != nil {
+		return
+	}
+	if result == nil {
+		err = fmt.Errorf("no task details returned for task %s", op.taskID)
+		return
+	}
+
+	op.mu.Lock()
+	var progressed []TaskNode
+	for _, node := range result.Nodes {
+		if node.ID == nil || node.State == nil {
+			continue
+		}
+		if op.nodeStates[*node.ID] != *node.State {
+			op.nodeStates[*node.ID] = *node.State
+			progressed = append(progressed, node)
+		}
+	}
+	callbacks := op.onNodeProgress
+	op.mu.Unlock()
+
+	for _, node := range progressed {
+		for _, callback := range callbacks {
+			callback(node)
+		}
+	}
+
+	state := result.GetState()
+	if !state.IsTerminal() {
+		return
+	}
+	if state == TaskStateFailed {
+		err = newTaskFailedError(op.clusterID, op.taskID, result)
+	}
+
+	op.mu.Lock()
+	defer op.mu.Unlock()
+	select {
+	case <-op.done:
+		// Another goroutine recorded the result first.
+		return op.task, op.err
+	default:
+		op.task, op.err = result, err
+		close(op.done)
+	}
+	return
+}
+
+// Await polls the task until it reaches the SUCCEEDED or FAILED state, or until the context is done. The final task is
+// returned; if it ended in the FAILED state a *TaskFailedError is returned as well.
+func (op *Operation) Await(ctx context.Context) (result *Task, err error) {
+	op.mu.Lock()
+	waitOptions := op.waitOptions
+	op.mu.Unlock()
+
+	var interval time.Duration
+	for {
+		result, err = op.Poll(ctx)
+		if err != nil {
+			return
+		}
+		select {
+		case <-op.done:
+			return
+		default:
+		}
+
+		interval = waitOptions.nextInterval(interval)
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			err = ctx.Err()
+			return
+		case <-op.done:
+			timer.Stop()
+			return op.Result()
+		case <-timer.C:
+		}
+	}
+}
+
+// ScaleResourcesAsync : Scale resources without waiting for the task to finish
+// Start scaling resources in a specified cluster that is indicated by its ID, and return an Operation which tracks the
+// resulting task.
+func (hpdb *HpdbV3) ScaleResourcesAsync(ctx context.Context, scaleResourcesOptions *ScaleResourcesOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	result, response, err := hpdb.ScaleResourcesWithContext(ctx, scaleResourcesOptions)
+	if err != nil {
+		return
+	}
+	operation, err = hpdb.newOperationFromTaskID(*scaleResourcesOptions.ClusterID, result)
+	return
+}
+
+// ScaleResourcesAndWait : Scale resources and wait for the task to finish
+// Scale resources in a specified cluster that is indicated by its ID, and wait for the resulting task to finish.
+func (hpdb *HpdbV3) ScaleResourcesAndWait(ctx context.Context, scaleResourcesOptions *ScaleResourcesOptions, waitForTaskOptions *WaitForTaskOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	operation, response, err = hpdb.ScaleResourcesAsync(ctx, scaleResourcesOptions)
+	if err != nil {
+		return
+	}
+	_, err = operation.SetWaitOptions(waitForTaskOptions).Await(ctx)
+	return
+}
+
+// UpdateConfigurationAsync : Update configuration without waiting for the task to finish
+// Start updating database configuration in a specified cluster that is indicated by its ID, and return an Operation
+// which tracks the resulting task.
+func (hpdb *HpdbV3) UpdateConfigurationAsync(ctx context.Context, updateConfigurationOptions *UpdateConfigurationOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	result, response, err := hpdb.UpdateConfigurationWithContext(ctx, updateConfigurationOptions)
+	if err != nil {
+		return
+	}
+	operation, err = hpdb.newOperationFromTaskID(*updateConfigurationOptions.ClusterID, result)
+	return
+}
+
+// UpdateConfigurationAndWait : Update configuration and wait for the task to finish
+// Update database configuration in a specified cluster that is indicated by its ID, and wait for the resulting task to
+// finish. The outcome of the task is recorded in the configuration journal, if one is set.
+func (hpdb *HpdbV3) UpdateConfigurationAndWait(ctx context.Context, updateConfigurationOptions *UpdateConfigurationOptions, waitForTaskOptions *WaitForTaskOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	operation, response, err = hpdb.UpdateConfigurationAsync(ctx, updateConfigurationOptions)
+	if err != nil {
+		return
+	}
+	_, err = operation.SetWaitOptions(waitForTaskOptions).Await(ctx)
+	if hpdb.configurationJournal != nil {
+		hpdb.recordConfigurationOutcome(operation)
+	}
+	return
+}
+
+// EnableCosBackupAsync : Enable backup to COS without waiting for the task to finish
+// Start enabling backup to COS, and return an Operation which tracks the resulting task.
+func (hpdb *HpdbV3) EnableCosBackupAsync(ctx context.Context, enableCosBackupOptions *EnableCosBackupOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	result, response, err := hpdb.EnableCosBackupWithContext(ctx, enableCosBackupOptions)
+	if err != nil {
+		return
+	}
+	operation, err = hpdb.newOperationFromTaskID(*enableCosBackupOptions.ClusterID, result)
+	return
+}
+
+// EnableCosBackupAndWait : Enable backup to COS and wait for the task to finish
+// Enable backup to COS, and wait for the resulting task to finish.
+func (hpdb *HpdbV3) EnableCosBackupAndWait(ctx context.Context, enableCosBackupOptions *EnableCosBackupOptions, waitForTaskOptions *WaitForTaskOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	operation, response, err = hpdb.EnableCosBackupAsync(ctx, enableCosBackupOptions)
+	if err != nil {
+		return
+	}
+	_, err = operation.SetWaitOptions(waitForTaskOptions).Await(ctx)
+	return
+}
+
+// DisableCosBackupAsync : Disable backup to COS without waiting for the task to finish
+// Start disabling backup to COS, and return an Operation which tracks the resulting task.
+func (hpdb *HpdbV3) DisableCosBackupAsync(ctx context.Context, disableCosBackupOptions *DisableCosBackupOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	result, response, err := hpdb.DisableCosBackupWithContext(ctx, disableCosBackupOptions)
+	if err != nil {
+		return
+	}
+	operation, err = hpdb.newOperationFromTaskID(*disableCosBackupOptions.ClusterID, result)
+	return
+}
+
+// DisableCosBackupAndWait : Disable backup to COS and wait for the task to finish
+// Disable backup to COS, and wait for the resulting task to finish.
+func (hpdb *HpdbV3) DisableCosBackupAndWait(ctx context.Context, disableCosBackupOptions *DisableCosBackupOptions, waitForTaskOptions *WaitForTaskOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	operation, response, err = hpdb.DisableCosBackupAsync(ctx, disableCosBackupOptions)
+	if err != nil {
+		return
+	}
+	_, err = operation.SetWaitOptions(waitForTaskOptions).Await(ctx)
+	return
+}
+
+// UpdateBackupConfigAsync : Update backup configuration without waiting for the task to finish
+// Start updating backup configuration, and return an Operation which tracks the resulting task.
+func (hpdb *HpdbV3) UpdateBackupConfigAsync(ctx context.Context, updateBackupConfigOptions *UpdateBackupConfigOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	result, response, err := hpdb.UpdateBackupConfigWithContext(ctx, updateBackupConfigOptions)
+	if err != nil {
+		return
+	}
+	operation, err = hpdb.newOperationFromTaskID(*updateBackupConfigOptions.ClusterID, result)
+	return
+}
+
+// UpdateBackupConfigAndWait : Update backup configuration and wait for the task to finish
+// Update backup configuration, and wait for the resulting task to finish.
+func (hpdb *HpdbV3) UpdateBackupConfigAndWait(ctx context.Context, updateBackupConfigOptions *UpdateBackupConfigOptions, waitForTaskOptions *WaitForTaskOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	operation, response, err = hpdb.UpdateBackupConfigAsync(ctx, updateBackupConfigOptions)
+	if err != nil {
+		return
+	}
+	_, err = operation.SetWaitOptions(waitForTaskOptions).Await(ctx)
+	return
+}
+
+// RestoreAsync : Restore from backup without waiting for the task to finish
+// Start restoring from a backup file, and return an Operation which tracks the resulting task.
+func (hpdb *HpdbV3) RestoreAsync(ctx context.Context, restoreOptions *RestoreOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	result, response, err := hpdb.RestoreWithContext(ctx, restoreOptions)
+	if err != nil {
+		return
+	}
+	operation, err = hpdb.newOperationFromTaskID(*restoreOptions.ClusterID, result)
+	return
+}
+
+// RestoreAndWait : Restore from backup and wait for the task to finish
+// Restore from a backup file, and wait for the resulting task to finish.
+func (hpdb *HpdbV3) RestoreAndWait(ctx context.Context, restoreOptions *RestoreOptions, waitForTaskOptions *WaitForTaskOptions) (operation *Operation, response *core.DetailedResponse, err error) {
+	operation, response, err = hpdb.RestoreAsync(ctx, restoreOptions)
+	if err != nil {
+		return
+	}
+	_, err = operation.SetWaitOptions(waitForTaskOptions).Await(ctx)
+	return
+}
@@ -0,0 +1,269 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`HpdbV3 operations`, func() {
+	var testServer *httptest.Server
+	clusterID := "9cebab98-afeb-4886-9a29-8e741716e7ff"
+	taskID := "c1a15760-a4f2-11ec-b00a-7f684d1dd53"
+	taskPath := "/clusters/9cebab98-afeb-4886-9a29-8e741716e7ff/tasks/c1a15760-a4f2-11ec-b00a-7f684d1dd53"
+
+	var newService = func() *hpdbv3.HpdbV3 {
+		hpdbService, serviceErr := hpdbv3.NewHpdbV3(&hpdbv3.HpdbV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		Expect(hpdbService).ToNot(BeNil())
+		return hpdbService
+	}
+	var fastWait = &hpdbv3.WaitForTaskOptions{Interval: time.Millisecond}
+
+	// The task progresses node by node: each GET moves one more node from RUNNING to the final state.
+	var newTaskServer = func(mutationPath string, mutationMethod string, finalState string) {
+		polls := 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.EscapedPath() {
+			case mutationPath:
+				Expect(req.Method).To(Equal(mutationMethod))
+				res.WriteHeader(202)
+				fmt.Fprintf(res, `{"task_id": "%s"}`, taskID)
+			case taskPath:
+				Expect(req.Method).To(Equal("GET"))
+				polls++
+				states := []string{"RUNNING", "RUNNING"}
+				for i := 0; i < polls-1 && i < len(states); i++ {
+					states[i] = finalState
+				}
+				state := "RUNNING"
+				if polls > len(states) {
+					state = finalState
+				}
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"id": "%s", "state": "%s", "reason": "reason", "nodes": [{"id": "node-1", "state": "%s", "reason": "reason"}, {"id": "node-2", "state": "%s", "reason": "reason"}]}`,
+					taskID, state, states[0], states[1])
+			default:
+				Fail("unexpected request path: " + req.URL.EscapedPath())
+			}
+		}))
+	}
+
+	Describe(`Operation`, func() {
+		Context(`Using mock server endpoint with a task that succeeds`, func() {
+			BeforeEach(func() {
+				newTaskServer("/clusters/9cebab98-afeb-4886-9a29-8e741716e7ff/resource", "PATCH", "SUCCEEDED")
+			})
+			It(`Invoke ScaleResourcesAndWait successfully`, func() {
+				hpdbService := newService()
+
+				scaleResourcesOptionsModel := hpdbService.NewScaleResourcesOptions(clusterID)
+				scaleResourcesOptionsModel.SetResource(&hpdbv3.Resources{Cpu: core.Int64Ptr(int64(2))})
+				operation, response, err := hpdbService.ScaleResourcesAndWait(context.Background(), scaleResourcesOptionsModel, fastWait)
+				Expect(err).To(BeNil())
+				Expect(response.StatusCode).To(Equal(202))
+				Expect(operation.ID()).To(Equal(taskID))
+				Expect(operation.ClusterID()).To(Equal(clusterID))
+				Eventually(operation.Done()).Should(BeClosed())
+
+				task, taskErr := operation.Result()
+				Expect(taskErr).To(BeNil())
+				Expect(*task.State).To(Equal("SUCCEEDED"))
+			})
+			It(`Invoke ScaleResourcesAsync and Poll successfully`, func() {
+				hpdbService := newService()
+
+				scaleResourcesOptionsModel := hpdbService.NewScaleResourcesOptions(clusterID)
+				operation, _, err := hpdbService.ScaleResourcesAsync(context.Background(), scaleResourcesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(operation.ID()).To(Equal(taskID))
+
+				var progress []string
+				operation.OnNodeProgress(func(node hpdbv3.TaskNode) {
+					progress = append(progress, *node.ID+"="+*node.State)
+				})
+
+				task, err := operation.Poll(context.Background())
+				Expect(err).To(BeNil())
+				Expect(*task.State).To(Equal("RUNNING"))
+				Expect(operation.Done()).ToNot(BeClosed())
+				task, err = operation.Result()
+				Expect(task).To(BeNil())
+				Expect(err).To(BeNil())
+
+				for i := 0; i < 3; i++ {
+					task, err = operation.Poll(context.Background())
+					Expect(err).To(BeNil())
+				}
+				Expect(*task.State).To(Equal("SUCCEEDED"))
+				Expect(operation.Done()).To(BeClosed())
+				Expect(progress).To(Equal([]string{
+					"node-1=RUNNING", "node-2=RUNNING",
+					"node-1=SUCCEEDED",
+					"node-2=SUCCEEDED",
+				}))
+
+				// A finished operation does not call the service again
+				testServer.Close()
+				task, err = operation.Poll(context.Background())
+				Expect(err).To(BeNil())
+				Expect(*task.State).To(Equal("SUCCEEDED"))
+			})
+			It(`Invoke Await with error: Context canceled`, func() {
+				hpdbService := newService()
+
+				ctx, cancelFunc := context.WithCancel(context.Background())
+				cancelFunc()
+				task, err := hpdbService.NewOperation(clusterID, taskID).Await(ctx)
+				Expect(err).ToNot(BeNil())
+				Expect(task).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+		Context(`Using mock server endpoint with a task that fails`, func() {
+			BeforeEach(func() {
+				newTaskServer("/clusters/9cebab98-afeb-4886-9a29-8e741716e7ff/restore", "POST", "FAILED")
+			})
+			It(`Invoke RestoreAndWait with error: Task failed`, func() {
+				hpdbService := newService()
+
+				restoreOptionsModel := hpdbService.NewRestoreOptions(clusterID)
+				restoreOptionsModel.SetSourceType("default")
+				restoreOptionsModel.SetBackupID("backup-1")
+				operation, response, err := hpdbService.RestoreAndWait(context.Background(), restoreOptionsModel, fastWait)
+				Expect(response.StatusCode).To(Equal(202))
+				Expect(operation).ToNot(BeNil())
+
+				var taskFailedErr *hpdbv3.TaskFailedError
+				Expect(errors.As(err, &taskFailedErr)).To(BeTrue())
+				Expect(taskFailedErr.Nodes).To(HaveLen(2))
+
+				task, taskErr := operation.Result()
+				Expect(taskErr).To(Equal(err))
+				Expect(*task.State).To(Equal("FAILED"))
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+		Context(`Using mock server endpoint for the remaining operations`, func() {
+			var mutationPath string
+			var mutationMethod string
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					res.Header().Set("Content-type", "application/json")
+					if req.URL.EscapedPath() == taskPath {
+						res.WriteHeader(200)
+						fmt.Fprintf(res, `{"id": "%s", "state": "SUCCEEDED"}`, taskID)
+						return
+					}
+					mutationPath = req.URL.EscapedPath()
+					mutationMethod = req.Method
+					res.WriteHeader(202)
+					fmt.Fprintf(res, `{"task_id": "%s"}`, taskID)
+				}))
+			})
+			It(`Invoke the AndWait operations successfully`, func() {
+				hpdbService := newService()
+				ctx := context.Background()
+
+				operation, _, err := hpdbService.UpdateConfigurationAndWait(ctx, hpdbService.NewUpdateConfigurationOptions(clusterID), fastWait)
+				Expect(err).To(BeNil())
+				Expect(operation.ID()).To(Equal(taskID))
+				Expect(mutationMethod).To(Equal("PATCH"))
+				Expect(mutationPath).To(Equal("/clusters/9cebab98-afeb-4886-9a29-8e741716e7ff/configuration"))
+
+				operation, _, err = hpdbService.EnableCosBackupAndWait(ctx, hpdbService.NewEnableCosBackupOptions(clusterID), fastWait)
+				Expect(err).To(BeNil())
+				Expect(operation.ID()).To(Equal(taskID))
+				Expect(mutationMethod).To(Equal("POST"))
+				Expect(mutationPath).To(Equal("/clusters/9cebab98-afeb-4886-9a29-8e741716e7ff/backups/cos/enable"))
+
+				operation, _, err = hpdbService.DisableCosBackupAndWait(ctx, hpdbService.NewDisableCosBackupOptions(clusterID), fastWait)
+				Expect(err).To(BeNil())
+				Expect(operation.ID()).To(Equal(taskID))
+				Expect(mutationMethod).To(Equal("POST"))
+				Expect(mutationPath).To(Equal("/clusters/9cebab98-afeb-4886-9a29-8e741716e7ff/backups/cos/disable"))
+
+				operation, _, err = hpdbService.UpdateBackupConfigAndWait(ctx, hpdbService.NewUpdateBackupConfigOptions(clusterID), fastWait)
+				Expect(err).To(BeNil())
+				Expect(operation.ID()).To(Equal(taskID))
+				Expect(mutationMethod).To(Equal("PUT"))
+				Expect(mutationPath).To(Equal("/clusters/9cebab98-afeb-4886-9a29-8e741716e7ff/backups/configuration"))
+			})
+			It(`Invoke the Async operations with error: Operation validation error`, func() {
+				hpdbService := newService()
+				ctx := context.Background()
+
+				operation, response, err := hpdbService.ScaleResourcesAsync(ctx, nil)
+				Expect(err).ToNot(BeNil())
+				Expect(response).To(BeNil())
+				Expect(operation).To(BeNil())
+
+				operation, response, err = hpdbService.RestoreAsync(ctx, new(hpdbv3.RestoreOptions))
+				Expect(err).ToNot(BeNil())
+				Expect(response).To(BeNil())
+				Expect(operation).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+		Context(`Using mock server endpoint with missing task ID`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(202)
+					fmt.Fprintf(res, "%s", `{}`)
+				}))
+			})
+			It(`Invoke ScaleResourcesAsync with error: Missing task ID`, func() {
+				hpdbService := newService()
+
+				operation, response, err := hpdbService.ScaleResourcesAsync(context.Background(), hpdbService.NewScaleResourcesOptions(clusterID))
+				Expect(err).ToNot(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(operation).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+})
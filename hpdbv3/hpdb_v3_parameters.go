@@ -0,0 +1,409 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ParameterType : The type of a database configuration parameter, as in the vartype column of pg_settings.
+type ParameterType string
+
+// The types of the database configuration parameters.
+const (
+	ParameterTypeInteger ParameterType = "integer"
+	ParameterTypeString  ParameterType = "string"
+	ParameterTypeBool    ParameterType = "bool"
+	ParameterTypeEnum    ParameterType = "enum"
+)
+
+var parameterTypes = []string{
+	string(ParameterTypeInteger), string(ParameterTypeString), string(ParameterTypeBool), string(ParameterTypeEnum),
+}
+
+// ParseParameterType returns the ParameterType of a string, ignoring case. Unknown types are returned as is.
+func ParseParameterType(s string) ParameterType {
+	return ParameterType(parseEnum(s, parameterTypes))
+}
+
+// IsKnown returns true if the type is one of the types known by this SDK.
+func (t ParameterType) IsKnown() bool {
+	return isKnownEnum(string(t), parameterTypes)
+}
+
+// ParameterValue : The value of a database configuration parameter: an integer, a string or a boolean. The values of
+// enum parameters are strings. The zero ParameterValue is not set and is encoded as null.
+type ParameterValue struct {
+	kind ParameterType
+	i    int64
+	s    string
+	b    bool
+}
+
+// IntegerValue returns the ParameterValue of an integer.
+func IntegerValue(i int64) ParameterValue {
+	return ParameterValue{kind: ParameterTypeInteger, i: i}
+}
+
+// StringValue returns the ParameterValue of a string, which is also used for enum parameters.
+func StringValue(s string) ParameterValue {
+	return ParameterValue{kind: ParameterTypeString, s: s}
+}
+
+// BoolValue returns the ParameterValue of a boolean.
+func BoolValue(b bool) ParameterValue {
+	return ParameterValue{kind: ParameterTypeBool, b: b}
+}
+
+// ParseParameterValue returns the ParameterValue of a string when the type of the parameter is not known: an integer
+// if it is one, a boolean if it is "true" or "false" (ignoring case), and a string otherwise.
+func ParseParameterValue(s string) ParameterValue {
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return IntegerValue(i)
+	}
+	if strings.EqualFold(s, "true") || strings.EqualFold(s, "false") {
+		return BoolValue(strings.EqualFold(s, "true"))
+	}
+	return StringValue(s)
+}
+
+// Kind returns ParameterTypeInteger, ParameterTypeString or ParameterTypeBool, or "" if the value is not set.
+func (v ParameterValue) Kind() ParameterType {
+	return v.kind
+}
+
+// IsSet returns true if the value is set.
+func (v ParameterValue) IsSet() bool {
+	return v.kind != ""
+}
+
+// Int64 returns the integer value, and false if the value is not an integer.
+func (v ParameterValue) Int64() (int64, bool) {
+	return v.i, v.kind == ParameterTypeInteger
+}
+
+// Bool returns the boolean value, and false if the value is not a boolean.
+func (v ParameterValue) Bool() (bool, bool) {
+	return v.b, v.kind == ParameterTypeBool
+}
+
+// String returns the value as a string, or "" if it is not set.
+func (v ParameterValue) String() string {
+	switch v.kind {
+	case ParameterTypeInteger:
+		return strconv.FormatInt(v.i, 10)
+	case ParameterTypeBool:
+		return strconv.FormatBool(v.b)
+	default:
+		return v.s
+	}
+}
+
+// MarshalJSON encodes the value as a JSON number, string or boolean, or null if it is not set.
+func (v ParameterValue) MarshalJSON() ([]byte, error) {
+	switch v.kind {
+	case ParameterTypeInteger, ParameterTypeBool:
+		return []byte(v.String()), nil
+	case ParameterTypeString:
+		return json.Marshal(v.s)
+	default:
+		return []byte("null"), nil
+	}
+}
+
+// UnmarshalJSON decodes a JSON number, string, boolean or null. Numbers which are not integers are kept as strings.
+func (v *ParameterValue) UnmarshalJSON(b []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	var x interface{}
+	if err := decoder.Decode(&x); err != nil {
+		return err
+	}
+	switch x := x.(type) {
+	case nil:
+		*v = ParameterValue{}
+	case json.Number:
+		if i, err := x.Int64(); err == nil {
+			*v = IntegerValue(i)
+		} else {
+			*v = StringValue(x.String())
+		}
+	case string:
+		*v = StringValue(x)
+	case bool:
+		*v = BoolValue(x)
+	default:
+		return fmt.Errorf("invalid parameter value %s: it must be a number, a string or a boolean", b)
+	}
+	return nil
+}
+
+// Parameter : A database configuration parameter of any type.
+type Parameter struct {
+	// Type of the value of the parameter.
+	Type ParameterType `json:"type,omitempty"`
+
+	// The current value of the parameter.
+	Value ParameterValue `json:"value"`
+
+	// Default value of the parameter.
+	Default ParameterValue `json:"default"`
+
+	// The minimum value of an integer parameter.
+	Min *int64 `json:"min,omitempty"`
+
+	// The max value of an integer parameter.
+	Max *int64 `json:"max,omitempty"`
+
+	// The values allowed for an enum parameter.
+	AllowedValues []string `json:"allowed_values,omitempty"`
+
+	// The unit of an integer parameter, such as 8kB or ms, as in the unit column of pg_settings. It is empty unless the
+	// service reports it: ParameterUnits then gives the units of the common parameters.
+	Unit string `json:"unit,omitempty"`
+
+	// The description of the parameter.
+	Description *string `json:"description,omitempty"`
+
+	// Whether to restart the database server when the value of the parameter is changed.
+	RequiresRestart *bool `json:"requires_restart,omitempty"`
+}
+
+// parameterFromIntegerType returns the Parameter of an integer type parameter.
+func parameterFromIntegerType(integerType *IntegerType) *Parameter {
+	parameter := &Parameter{
+		Type:            ParameterTypeInteger,
+		Min:             integerType.Min,
+		Max:             integerType.Max,
+		Description:     integerType.Description,
+		RequiresRestart: integerType.RequiresRestart,
+	}
+	if integerType.Type != nil {
+		parameter.Type = ParseParameterType(*integerType.Type)
+	}
+	if integerType.Value != nil {
+		parameter.Value = IntegerValue(*integerType.Value)
+	}
+	if integerType.Default != nil {
+		parameter.Default = IntegerValue(*integerType.Default)
+	}
+	return parameter
+}
+
+// integerType returns the IntegerType of the parameter, or nil if it is not an integer parameter.
+func (parameter *Parameter) integerType() *IntegerType {
+	if parameter == nil || parameter.Type != ParameterTypeInteger {
+		return nil
+	}
+	integerType := &IntegerType{
+		Description:     parameter.Description,
+		Max:             parameter.Max,
+		Min:             parameter.Min,
+		RequiresRestart: parameter.RequiresRestart,
+		Type:            core.StringPtr(string(parameter.Type)),
+	}
+	if i, ok := parameter.Value.Int64(); ok {
+		integerType.Value = core.Int64Ptr(i)
+	}
+	if i, ok := parameter.Default.Int64(); ok {
+		integerType.Default = core.Int64Ptr(i)
+	}
+	return integerType
+}
+
+// typedParameters returns the fields of the parameters which have one, by name.
+func (configurationItem *ConfigurationItem) typedParameters() map[string]**IntegerType {
+	return map[string]**IntegerType{
+		"deadlock_timeout":          &configurationItem.DeadlockTimeout,
+		"max_locks_per_transaction": &configurationItem.MaxLocksPerTransaction,
+		"shared_buffers":            &configurationItem.SharedBuffers,
+		"max_connections":           &configurationItem.MaxConnections,
+		"max_prepared_transactions": &configurationItem.MaxPreparedTransactions,
+	}
+}
+
+// GetParameters returns all the parameters by name: the ones of the Parameters map and the ones which have a field,
+// such as MaxConnections. The fields take precedence over the map.
+func (configurationItem *ConfigurationItem) GetParameters() map[string]*Parameter {
+	parameters := make(map[string]*Parameter)
+	if configurationItem == nil {
+		return parameters
+	}
+	for name, parameter := range configurationItem.Parameters {
+		if parameter != nil {
+			parameters[name] = parameter
+		}
+	}
+	for name, field := range configurationItem.typedParameters() {
+		if *field != nil {
+			parameters[name] = configurationItem.typedParameter(name, *field)
+		}
+	}
+	return parameters
+}
+
+// typedParameter returns the Parameter of a parameter which has a field, with the unit reported for it, which the
+// IntegerType of the field does not hold.
+func (configurationItem *ConfigurationItem) typedParameter(name string, integerType *IntegerType) *Parameter {
+	parameter := parameterFromIntegerType(integerType)
+	if reported := configurationItem.Parameters[name]; reported != nil {
+		parameter.Unit = reported.Unit
+	}
+	return parameter
+}
+
+// GetParameter returns the parameter with the specified name, or nil if there is none.
+func (configurationItem *ConfigurationItem) GetParameter(name string) *Parameter {
+	if configurationItem == nil {
+		return nil
+	}
+	if field, ok := configurationItem.typedParameters()[name]; ok && *field != nil {
+		return configurationItem.typedParameter(name, *field)
+	}
+	return configurationItem.Parameters[name]
+}
+
+// ParameterNames returns the names of all the parameters, sorted.
+func (configurationItem *ConfigurationItem) ParameterNames() []string {
+	var names []string
+	for name := range configurationItem.GetParameters() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SetParameter sets the parameter with the specified name, including its field if it has one.
+func (configurationItem *ConfigurationItem) SetParameter(name string, parameter *Parameter) *ConfigurationItem {
+	if field, ok := configurationItem.typedParameters()[name]; ok {
+		*field = parameter.integerType()
+	}
+	if configurationItem.Parameters == nil {
+		configurationItem.Parameters = make(map[string]*Parameter)
+	}
+	configurationItem.Parameters[name] = parameter
+	return configurationItem
+}
+
+// MarshalJSON encodes all the parameters returned by GetParameters.
+func (configurationItem ConfigurationItem) MarshalJSON() ([]byte, error) {
+	return json.Marshal(configurationItem.GetParameters())
+}
+
+// typedParameters returns the fields of the parameters which have one, by name.
+func (configurations *Configurations) typedParameters() map[string]**int64 {
+	return map[string]**int64{
+		"deadlock_timeout":          &configurations.DeadlockTimeout,
+		"max_locks_per_transaction": &configurations.MaxLocksPerTransaction,
+		"shared_buffers":            &configurations.SharedBuffers,
+		"max_connections":           &configurations.MaxConnections,
+		"max_prepared_transactions": &configurations.MaxPreparedTransactions,
+	}
+}
+
+// GetParameters returns the values of all the parameters to be updated by name: the ones of the Parameters map and
+// the ones which have a field, such as MaxConnections. The fields take precedence over the map.
+func (configurations *Configurations) GetParameters() map[string]ParameterValue {
+	parameters := make(map[string]ParameterValue)
+	if configurations == nil {
+		return parameters
+	}
+	for name, value := range configurations.Parameters {
+		if value.IsSet() {
+			parameters[name] = value
+		}
+	}
+	for name, field := range configurations.typedParameters() {
+		if *field != nil {
+			parameters[name] = IntegerValue(**field)
+		}
+	}
+	return parameters
+}
+
+// SetParameter sets the value of the parameter with the specified name to be updated. The integer values of the
+// parameters which have a field, such as max_connections, are set in the field.
+func (configurations *Configurations) SetParameter(name string, value ParameterValue) *Configurations {
+	if field, ok := configurations.typedParameters()[name]; ok {
+		*field = nil
+		if i, isInteger := value.Int64(); isInteger {
+			*field = core.Int64Ptr(i)
+			delete(configurations.Parameters, name)
+			return configurations
+		}
+	}
+	if configurations.Parameters == nil {
+		configurations.Parameters = make(map[string]ParameterValue)
+	}
+	configurations.Parameters[name] = value
+	return configurations
+}
+
+// MarshalJSON encodes the values of all the parameters returned by GetParameters.
+func (configurations Configurations) MarshalJSON() ([]byte, error) {
+	return json.Marshal(configurations.GetParameters())
+}
+
+// unmarshalParameters unmarshals all the parameters of a configuration item.
+func unmarshalParameters(m map[string]json.RawMessage, result *map[string]*Parameter) error {
+	parameters := make(map[string]*Parameter)
+	for name, raw := range m {
+		parameter := new(Parameter)
+		if err := json.Unmarshal(raw, &parameter); err != nil {
+			return fmt.Errorf("error unmarshalling parameter %s: %w", name, err)
+		}
+		if parameter == nil {
+			continue
+		}
+		// The types are case-insensitive, such as Integer or integer
+		parameter.Type = ParseParameterType(string(parameter.Type))
+		if parameter.Type == "" {
+			parameter.Type = parameter.Value.Kind()
+		}
+		parameters[name] = parameter
+	}
+	*result = parameters
+	return nil
+}
+
+// unmarshalParameterValues unmarshals the values of the parameters of a Configurations which do not have a field.
+func unmarshalParameterValues(m map[string]json.RawMessage, result *map[string]ParameterValue) error {
+	typed := (&Configurations{}).typedParameters()
+	var values map[string]ParameterValue
+	for name, raw := range m {
+		if _, ok := typed[name]; ok {
+			continue
+		}
+		var value ParameterValue
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return fmt.Errorf("error unmarshalling parameter %s: %w", name, err)
+		}
+		if values == nil {
+			values = make(map[string]ParameterValue)
+		}
+		values[name] = value
+	}
+	*result = values
+	return nil
+}
@@ -0,0 +1,220 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3/hpdbv3test"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`HpdbV3 configuration parameters`, func() {
+	Describe(`ParameterValue`, func() {
+		It(`Invoke ParseParameterValue successfully`, func() {
+			Expect(hpdbv3.ParseParameterValue("200")).To(Equal(hpdbv3.IntegerValue(200)))
+			Expect(hpdbv3.ParseParameterValue("TRUE")).To(Equal(hpdbv3.BoolValue(true)))
+			Expect(hpdbv3.ParseParameterValue("false")).To(Equal(hpdbv3.BoolValue(false)))
+			Expect(hpdbv3.ParseParameterValue("warning")).To(Equal(hpdbv3.StringValue("warning")))
+			Expect(hpdbv3.ParseParameterValue("")).To(Equal(hpdbv3.StringValue("")))
+		})
+		It(`Invoke accessors successfully`, func() {
+			i, ok := hpdbv3.IntegerValue(38).Int64()
+			Expect(i).To(Equal(int64(38)))
+			Expect(ok).To(BeTrue())
+			_, ok = hpdbv3.StringValue("38").Int64()
+			Expect(ok).To(BeFalse())
+			b, ok := hpdbv3.BoolValue(true).Bool()
+			Expect(b).To(BeTrue())
+			Expect(ok).To(BeTrue())
+
+			Expect(hpdbv3.IntegerValue(38).String()).To(Equal("38"))
+			Expect(hpdbv3.BoolValue(true).String()).To(Equal("true"))
+			Expect(hpdbv3.StringValue("warning").Kind()).To(Equal(hpdbv3.ParameterTypeString))
+			Expect(hpdbv3.ParameterValue{}.IsSet()).To(BeFalse())
+			Expect(hpdbv3.ParameterValue{}.String()).To(Equal(""))
+		})
+		It(`Invoke MarshalJSON and UnmarshalJSON successfully`, func() {
+			values := []hpdbv3.ParameterValue{hpdbv3.IntegerValue(38), hpdbv3.StringValue("warning"), hpdbv3.BoolValue(true), {}}
+			b, err := json.Marshal(values)
+			Expect(err).To(BeNil())
+			Expect(string(b)).To(Equal(`[38,"warning",true,null]`))
+
+			var decoded []hpdbv3.ParameterValue
+			Expect(json.Unmarshal(b, &decoded)).To(Succeed())
+			Expect(decoded).To(Equal(values))
+
+			var value hpdbv3.ParameterValue
+			Expect(json.Unmarshal([]byte(`1.5`), &value)).To(Succeed())
+			Expect(value).To(Equal(hpdbv3.StringValue("1.5")))
+			Expect(json.Unmarshal([]byte(`[1]`), &value)).ToNot(Succeed())
+		})
+		It(`Invoke ParseParameterType successfully`, func() {
+			Expect(hpdbv3.ParseParameterType("Enum")).To(Equal(hpdbv3.ParameterTypeEnum))
+			Expect(hpdbv3.ParseParameterType("real").IsKnown()).To(BeFalse())
+		})
+	})
+	Describe(`ConfigurationItem`, func() {
+		It(`Invoke UnmarshalConfiguration successfully with parameters of any type`, func() {
+			var m map[string]json.RawMessage
+			Expect(json.Unmarshal([]byte(`{"configuration": {
+				"max_connections": {"type": "integer", "value": 200, "default": 115, "min": 1, "max": 262143, "requires_restart": true},
+				"shared_buffers": {"type": "integer", "value": 16384, "unit": "8kB"},
+				"log_connections": {"type": "Bool", "value": true, "default": false},
+				"log_min_messages": {"type": "ENUM", "value": "error", "allowed_values": ["warning", "error"]},
+				"search_path": {"value": "public"}
+			}}`), &m)).To(Succeed())
+			var configuration *hpdbv3.Configuration
+			Expect(core.UnmarshalModel(m, "", &configuration, hpdbv3.UnmarshalConfiguration)).To(Succeed())
+			item := configuration.Configuration
+
+			// The fields are still set
+			Expect(*item.MaxConnections.Value).To(Equal(int64(200)))
+			Expect(item.DeadlockTimeout).To(BeNil())
+
+			Expect(item.ParameterNames()).To(Equal([]string{"log_connections", "log_min_messages", "max_connections", "search_path", "shared_buffers"}))
+			Expect(item.GetParameter("max_connections")).To(Equal(&hpdbv3.Parameter{
+				Type:            hpdbv3.ParameterTypeInteger,
+				Value:           hpdbv3.IntegerValue(200),
+				Default:         hpdbv3.IntegerValue(115),
+				Min:             core.Int64Ptr(1),
+				Max:             core.Int64Ptr(262143),
+				RequiresRestart: core.BoolPtr(true),
+			}))
+			// The unit reported for a parameter which has a field is kept
+			Expect(item.GetParameter("shared_buffers").Unit).To(Equal("8kB"))
+			Expect(item.GetParameters()["shared_buffers"].Unit).To(Equal("8kB"))
+			Expect(item.GetParameter("max_connections").Unit).To(BeEmpty())
+			Expect(item.GetParameter("log_connections").Value).To(Equal(hpdbv3.BoolValue(true)))
+			Expect(item.GetParameter("log_connections").Type).To(Equal(hpdbv3.ParameterTypeBool))
+			Expect(item.GetParameter("log_min_messages").Type).To(Equal(hpdbv3.ParameterTypeEnum))
+			Expect(item.GetParameter("log_min_messages").AllowedValues).To(Equal([]string{"warning", "error"}))
+			Expect(item.GetParameter("search_path").Type).To(Equal(hpdbv3.ParameterTypeString))
+			Expect(item.GetParameter("work_mem")).To(BeNil())
+		})
+		It(`Invoke GetParameters successfully with the fields only`, func() {
+			item := &hpdbv3.ConfigurationItem{MaxConnections: &hpdbv3.IntegerType{Value: core.Int64Ptr(200)}}
+			parameters := item.GetParameters()
+			Expect(parameters).To(HaveLen(1))
+			Expect(parameters["max_connections"].Value).To(Equal(hpdbv3.IntegerValue(200)))
+
+			// The fields take precedence over the map
+			item.Parameters = map[string]*hpdbv3.Parameter{"max_connections": {Type: hpdbv3.ParameterTypeInteger, Value: hpdbv3.IntegerValue(100)}}
+			Expect(item.GetParameter("max_connections").Value).To(Equal(hpdbv3.IntegerValue(200)))
+
+			var nilItem *hpdbv3.ConfigurationItem
+			Expect(nilItem.GetParameters()).To(BeEmpty())
+			Expect(nilItem.GetParameter("max_connections")).To(BeNil())
+		})
+		It(`Invoke SetParameter successfully`, func() {
+			item := &hpdbv3.ConfigurationItem{}
+			item.SetParameter("max_connections", &hpdbv3.Parameter{Type: hpdbv3.ParameterTypeInteger, Value: hpdbv3.IntegerValue(200)})
+			item.SetParameter("log_connections", &hpdbv3.Parameter{Type: hpdbv3.ParameterTypeBool, Value: hpdbv3.BoolValue(true)})
+			Expect(*item.MaxConnections.Value).To(Equal(int64(200)))
+			Expect(item.ParameterNames()).To(Equal([]string{"log_connections", "max_connections"}))
+
+			b, err := json.Marshal(item)
+			Expect(err).To(BeNil())
+			Expect(string(b)).To(MatchJSON(`{
+				"log_connections": {"type": "bool", "value": true, "default": null},
+				"max_connections": {"type": "integer", "value": 200, "default": null}
+			}`))
+		})
+	})
+	Describe(`Configurations`, func() {
+		It(`Invoke SetParameter successfully`, func() {
+			configurations := &hpdbv3.Configurations{DeadlockTimeout: core.Int64Ptr(2000)}
+			configurations.
+				SetParameter("max_connections", hpdbv3.IntegerValue(200)).
+				SetParameter("log_connections", hpdbv3.BoolValue(true)).
+				SetParameter("log_min_messages", hpdbv3.StringValue("error"))
+			Expect(*configurations.MaxConnections).To(Equal(int64(200)))
+			Expect(configurations.GetParameters()).To(Equal(map[string]hpdbv3.ParameterValue{
+				"deadlock_timeout": hpdbv3.IntegerValue(2000),
+				"max_connections":  hpdbv3.IntegerValue(200),
+				"log_connections":  hpdbv3.BoolValue(true),
+				"log_min_messages": hpdbv3.StringValue("error"),
+			}))
+
+			b, err := json.Marshal(configurations)
+			Expect(err).To(BeNil())
+			Expect(string(b)).To(MatchJSON(`{"deadlock_timeout": 2000, "max_connections": 200, "log_connections": true, "log_min_messages": "error"}`))
+
+			var m map[string]json.RawMessage
+			Expect(json.Unmarshal(b, &m)).To(Succeed())
+			var decoded *hpdbv3.Configurations
+			Expect(core.UnmarshalModel(m, "", &decoded, hpdbv3.UnmarshalConfigurations)).To(Succeed())
+			Expect(decoded).To(Equal(configurations))
+		})
+		It(`Invoke SetParameter successfully with a value which does not fit the field`, func() {
+			configurations := &hpdbv3.Configurations{MaxConnections: core.Int64Ptr(200)}
+			configurations.SetParameter("max_connections", hpdbv3.StringValue("300"))
+			Expect(configurations.MaxConnections).To(BeNil())
+			Expect(configurations.GetParameters()).To(Equal(map[string]hpdbv3.ParameterValue{"max_connections": hpdbv3.StringValue("300")}))
+		})
+	})
+	Describe(`UpdateConfiguration(updateConfigurationOptions *UpdateConfigurationOptions)`, func() {
+		var server *hpdbv3test.Server
+		var hpdbService *hpdbv3.HpdbV3
+		clusterID := "9cebab98-afeb-4886-9a29-8e741716e7ff"
+
+		BeforeEach(func() {
+			server = hpdbv3test.NewServer()
+			server.SetTaskDuration(0)
+			server.AddCluster(hpdbv3test.NewCluster(clusterID))
+			var err error
+			hpdbService, err = server.NewClient()
+			Expect(err).To(BeNil())
+		})
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It(`Invoke UpdateConfiguration successfully with parameters of any type`, func() {
+			configurations := (&hpdbv3.Configurations{}).
+				SetParameter("max_connections", hpdbv3.IntegerValue(200)).
+				SetParameter("work_mem", hpdbv3.IntegerValue(8192)).
+				SetParameter("log_connections", hpdbv3.BoolValue(true)).
+				SetParameter("log_min_messages", hpdbv3.StringValue("error"))
+			_, _, err := hpdbService.UpdateConfigurationAndWait(context.Background(),
+				hpdbService.NewUpdateConfigurationOptions(clusterID).SetConfiguration(configurations),
+				&hpdbv3.WaitForTaskOptions{Interval: time.Millisecond})
+			Expect(err).To(BeNil())
+
+			configuration, _, err := hpdbService.GetConfiguration(hpdbService.NewGetConfigurationOptions(clusterID))
+			Expect(err).To(BeNil())
+			item := configuration.Configuration
+			Expect(*item.MaxConnections.Value).To(Equal(int64(200)))
+			Expect(item.GetParameter("work_mem").Value).To(Equal(hpdbv3.IntegerValue(8192)))
+			Expect(item.GetParameter("log_connections").Value).To(Equal(hpdbv3.BoolValue(true)))
+			Expect(item.GetParameter("log_connections").Type).To(Equal(hpdbv3.ParameterTypeBool))
+			Expect(item.GetParameter("log_min_messages").Type).To(Equal(hpdbv3.ParameterTypeEnum))
+			Expect(item.GetParameter("log_min_messages").Value).To(Equal(hpdbv3.StringValue("error")))
+		})
+		It(`Invoke UpdateConfiguration with error: Invalid value`, func() {
+			configurations := (&hpdbv3.Configurations{}).SetParameter("log_min_messages", hpdbv3.StringValue("verbose"))
+			_, response, err := hpdbService.UpdateConfiguration(hpdbService.NewUpdateConfigurationOptions(clusterID).SetConfiguration(configurations))
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(400))
+		})
+	})
+})
@@ -0,0 +1,319 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ParameterUnits : The units of the integer parameters, as in the unit column of pg_settings. They are used by
+// FormatPostgresqlConf and ParsePostgresqlConf when the service does not report the unit of a parameter.
+var ParameterUnits = map[string]string{
+	"autovacuum_naptime":                  "s",
+	"checkpoint_timeout":                  "s",
+	"deadlock_timeout":                    "ms",
+	"effective_cache_size":                "8kB",
+	"idle_in_transaction_session_timeout": "ms",
+	"lock_timeout":                        "ms",
+	"log_min_duration_statement":          "ms",
+	"maintenance_work_mem":                "kB",
+	"max_wal_size":                        "MB",
+	"min_wal_size":                        "MB",
+	"shared_buffers":                      "8kB",
+	"statement_timeout":                   "ms",
+	"temp_buffers":                        "8kB",
+	"wal_buffers":                         "8kB",
+	"work_mem":                            "kB",
+}
+
+// confUnit : A unit of postgresql.conf, with its size in the smallest unit of its kind: bytes or microseconds.
+type confUnit struct {
+	name string
+	size int64
+}
+
+// The memory and time units of postgresql.conf, from the largest to the smallest.
+var (
+	memoryUnits = []confUnit{{"TB", 1 << 40}, {"GB", 1 << 30}, {"MB", 1 << 20}, {"kB", 1 << 10}, {"B", 1}}
+	timeUnits   = []confUnit{{"d", 86400000000}, {"h", 3600000000}, {"min", 60000000}, {"s", 1000000}, {"ms", 1000}, {"us", 1}}
+)
+
+// FormatPostgresqlConf returns the parameters of the configuration in the postgresql.conf format, sorted by name.
+// Integer values are written with the largest unit which represents them exactly, such as 128MB for shared_buffers, in
+// the unit reported by the service or else the one of ParameterUnits. The integers of the other parameters are written
+// as is, and the parameters which restart the database server when they are changed are commented as such.
+func FormatPostgresqlConf(configuration *Configuration) string {
+	if configuration == nil {
+		return ""
+	}
+	var b strings.Builder
+	item := configuration.Configuration
+	for _, name := range item.ParameterNames() {
+		parameter := item.GetParameter(name)
+		if !parameter.Value.IsSet() {
+			continue
+		}
+		fmt.Fprintf(&b, "%s = %s", name, formatConfValue(parameter.Value, parameterUnit(name, parameter)))
+		if parameter.RequiresRestart != nil && *parameter.RequiresRestart {
+			b.WriteString("\t# (change requires restart)")
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// ParsePostgresqlConf parses text in the postgresql.conf format into the Configurations to update. The current
+// configuration, as returned by GetConfiguration, gives the types of the parameters: integers may have a unit suffix,
+// such as 128MB or 1s, if the parameter has a unit, and booleans may be written on, off, true, false, yes, no, 1 or 0.
+// Parameters which are not in the current configuration are rejected. If a parameter is set several times, the last
+// value is used.
+//
+// Use a ConfigurationPlanner to update only the parameters whose value changes.
+func ParsePostgresqlConf(text string, current *Configuration) (*Configurations, error) {
+	if current == nil || current.Configuration == nil {
+		return nil, fmt.Errorf("the current configuration is required to parse postgresql.conf")
+	}
+	configurations := &Configurations{}
+	for i, line := range strings.Split(text, "\n") {
+		name, raw, ok, err := parseConfLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", i+1, err.Error())
+		}
+		if !ok {
+			continue
+		}
+		parameter := current.Configuration.GetParameter(name)
+		if parameter == nil {
+			return nil, fmt.Errorf("line %d: unknown parameter %s", i+1, name)
+		}
+		value, err := parseConfValue(raw, parameter.Type, parameterUnit(name, parameter))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid value of %s: %s", i+1, name, err.Error())
+		}
+		configurations.SetParameter(name, value)
+	}
+	return configurations, nil
+}
+
+// parameterUnit returns the unit of a parameter, as reported by the service or else as in ParameterUnits, or "" if it
+// has none.
+func parameterUnit(name string, parameter *Parameter) string {
+	if parameter.Unit != "" {
+		return parameter.Unit
+	}
+	return ParameterUnits[name]
+}
+
+// parseConfLine parses a line of postgresql.conf into the name and the unquoted value of a parameter. It returns false
+// if the line is blank or a comment.
+func parseConfLine(line string) (name string, value string, ok bool, err error) {
+	rest := strings.TrimSpace(line)
+	if rest == "" || rest[0] == '#' {
+		return
+	}
+	end := strings.IndexFunc(rest, func(r rune) bool {
+		return !(r == '_' || r == '.' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z')
+	})
+	if end == 0 {
+		return "", "", false, fmt.Errorf("syntax error: a parameter name is expected")
+	}
+	if end < 0 {
+		end = len(rest)
+	}
+	name = strings.ToLower(rest[:end])
+	if strings.HasPrefix(name, "include") {
+		return "", "", false, fmt.Errorf("%s directives are not supported", name)
+	}
+	rest = strings.TrimSpace(rest[end:])
+	rest = strings.TrimSpace(strings.TrimPrefix(rest, "="))
+
+	if strings.HasPrefix(rest, "'") {
+		value, rest, err = unquoteConfValue(rest)
+		if err != nil {
+			return "", "", false, err
+		}
+	} else {
+		end = strings.IndexAny(rest, " \t#")
+		if end < 0 {
+			end = len(rest)
+		}
+		value, rest = rest[:end], rest[end:]
+		if value == "" {
+			return "", "", false, fmt.Errorf("syntax error: a value is expected for %s", name)
+		}
+	}
+	if rest = strings.TrimSpace(rest); rest != "" && rest[0] != '#' {
+		return "", "", false, fmt.Errorf("syntax error: unexpected %q after the value of %s", rest, name)
+	}
+	return name, value, true, nil
+}
+
+// unquoteConfValue parses a single-quoted value, in which quotes are doubled or escaped with a backslash, and returns the
+// rest of the line.
+func unquoteConfValue(s string) (value string, rest string, err error) {
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\'' && i+1 < len(s) && s[i+1] == '\'':
+			b.WriteByte('\'')
+			i++
+		case c == '\'':
+			return b.String(), s[i+1:], nil
+		case c == '\\' && i+1 < len(s):
+			i++
+			switch s[i] {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			case 'r':
+				b.WriteByte('\r')
+			default:
+				b.WriteByte(s[i])
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", "", fmt.Errorf("syntax error: unterminated quoted value")
+}
+
+// quoteConfValue quotes a string value for postgresql.conf, so that it stays on one line.
+func quoteConfValue(s string) string {
+	s = strings.NewReplacer(`\`, `\\`, "'", "''", "\n", `\n`, "\r", `\r`).Replace(s)
+	return "'" + s + "'"
+}
+
+// formatConfValue formats a value for postgresql.conf.
+func formatConfValue(value ParameterValue, unit string) string {
+	switch value.Kind() {
+	case ParameterTypeInteger:
+		i, _ := value.Int64()
+		return formatConfInteger(i, unit)
+	case ParameterTypeBool:
+		if b, _ := value.Bool(); b {
+			return "on"
+		}
+		return "off"
+	default:
+		return quoteConfValue(value.String())
+	}
+}
+
+// formatConfInteger formats an integer in a unit with the largest unit which represents it exactly. Values which are not
+// positive, such as -1 which often disables a feature, are written without a unit.
+func formatConfInteger(i int64, unit string) string {
+	multiplier, units, base := splitConfUnit(unit)
+	if i <= 0 || units == nil || i > math.MaxInt64/(multiplier*base.size) {
+		return strconv.FormatInt(i, 10)
+	}
+	amount := i * multiplier * base.size
+	for _, u := range units {
+		if amount%u.size == 0 {
+			return strconv.FormatInt(amount/u.size, 10) + u.name
+		}
+	}
+	return strconv.FormatInt(i, 10)
+}
+
+// splitConfUnit splits a unit such as 8kB into its multiplier, the units of its kind, and its base unit. The units are
+// nil if the unit is not a memory or time unit.
+func splitConfUnit(unit string) (multiplier int64, units []confUnit, base confUnit) {
+	end := strings.IndexFunc(unit, func(r rune) bool { return r < '0' || r > '9' })
+	if end < 0 {
+		return 1, nil, confUnit{}
+	}
+	multiplier = 1
+	if end > 0 {
+		m, err := strconv.ParseInt(unit[:end], 10, 64)
+		if err != nil || m <= 0 {
+			return 1, nil, confUnit{}
+		}
+		multiplier = m
+	}
+	for _, kind := range [][]confUnit{memoryUnits, timeUnits} {
+		for _, u := range kind {
+			if u.name == unit[end:] {
+				return multiplier, kind, u
+			}
+		}
+	}
+	return 1, nil, confUnit{}
+}
+
+// parseConfValue parses a value of postgresql.conf for a parameter of the specified type and unit.
+func parseConfValue(s string, parameterType ParameterType, unit string) (ParameterValue, error) {
+	switch parameterType {
+	case ParameterTypeInteger:
+		i, err := parseConfInteger(s, unit)
+		if err != nil {
+			return ParameterValue{}, err
+		}
+		return IntegerValue(i), nil
+	case ParameterTypeBool:
+		switch strings.ToLower(s) {
+		case "on", "true", "yes", "1":
+			return BoolValue(true), nil
+		case "off", "false", "no", "0":
+			return BoolValue(false), nil
+		}
+		return ParameterValue{}, fmt.Errorf("%q is not a boolean", s)
+	default:
+		return StringValue(s), nil
+	}
+}
+
+// parseConfInteger parses an integer with an optional unit suffix, such as 128MB, into the unit of the parameter.
+func parseConfInteger(s string, unit string) (int64, error) {
+	end := strings.IndexFunc(s, func(r rune) bool { return !(r == '-' || r == '+' || r >= '0' && r <= '9') })
+	if end < 0 {
+		end = len(s)
+	}
+	i, err := strconv.ParseInt(s[:end], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not an integer", s)
+	}
+	suffix := strings.TrimSpace(s[end:])
+	if suffix == "" {
+		return i, nil
+	}
+
+	multiplier, units, base := splitConfUnit(unit)
+	if units == nil {
+		return 0, fmt.Errorf("%q has a unit, but the parameter has none", s)
+	}
+	var names []string
+	for _, u := range units {
+		names = append(names, u.name)
+		if u.name != suffix {
+			continue
+		}
+		if i > math.MaxInt64/u.size || i < math.MinInt64/u.size {
+			return 0, fmt.Errorf("%q is out of range", s)
+		}
+		baseSize := multiplier * base.size
+		amount := i * u.size
+		if amount%baseSize != 0 {
+			return 0, fmt.Errorf("%q is not a multiple of %s", s, unit)
+		}
+		return amount / baseSize, nil
+	}
+	return 0, fmt.Errorf("%q has an invalid unit: valid units are %s", s, strings.Join(names, ", "))
+}
@@ -0,0 +1,167 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3_test
+
+import (
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3/hpdbv3test"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`HpdbV3 postgresql.conf`, func() {
+	var current *hpdbv3.Configuration
+
+	BeforeEach(func() {
+		configuration := hpdbv3test.NewConfiguration()
+		current = &hpdbv3.Configuration{Configuration: &configuration}
+	})
+
+	Describe(`FormatPostgresqlConf(configuration *Configuration)`, func() {
+		It(`Invoke FormatPostgresqlConf successfully`, func() {
+			Expect(hpdbv3.FormatPostgresqlConf(current)).To(Equal("" +
+				"deadlock_timeout = 1s\n" +
+				"log_connections = off\n" +
+				"log_min_messages = 'warning'\n" +
+				"max_connections = 115\t# (change requires restart)\n" +
+				"max_locks_per_transaction = 64\t# (change requires restart)\n" +
+				"max_prepared_transactions = 0\t# (change requires restart)\n" +
+				"shared_buffers = 128MB\t# (change requires restart)\n" +
+				"work_mem = 4MB\n"))
+			Expect(hpdbv3.FormatPostgresqlConf(nil)).To(Equal(""))
+		})
+		It(`Invoke FormatPostgresqlConf successfully with units reported by the service`, func() {
+			// The reported units take precedence over ParameterUnits
+			reportUnits(current.Configuration, map[string]string{"work_mem": "MB", "max_connections": "kB"})
+			conf := hpdbv3.FormatPostgresqlConf(current)
+			Expect(conf).To(ContainSubstring("shared_buffers = 128MB\t# (change requires restart)\n"))
+			Expect(conf).To(ContainSubstring("work_mem = 4GB\n"))
+			Expect(conf).To(ContainSubstring("max_connections = 115kB\t# (change requires restart)\n"))
+		})
+		It(`Invoke FormatPostgresqlConf successfully with values which do not fit a larger unit`, func() {
+			item := &hpdbv3.ConfigurationItem{}
+			item.SetParameter("deadlock_timeout", &hpdbv3.Parameter{Type: hpdbv3.ParameterTypeInteger, Value: hpdbv3.IntegerValue(1500), Unit: "ms"})
+			item.SetParameter("shared_buffers", &hpdbv3.Parameter{Type: hpdbv3.ParameterTypeInteger, Value: hpdbv3.IntegerValue(3), Unit: "8kB"})
+			item.SetParameter("statement_timeout", &hpdbv3.Parameter{Type: hpdbv3.ParameterTypeInteger, Value: hpdbv3.IntegerValue(0), Unit: "ms"})
+			item.SetParameter("checkpoint_timeout", &hpdbv3.Parameter{Type: hpdbv3.ParameterTypeInteger, Value: hpdbv3.IntegerValue(7200), Unit: "s"})
+			item.SetParameter("wal_segment_size", &hpdbv3.Parameter{Type: hpdbv3.ParameterTypeInteger, Value: hpdbv3.IntegerValue(2), Unit: "16MB"})
+			item.SetParameter("search_path", &hpdbv3.Parameter{Type: hpdbv3.ParameterTypeString, Value: hpdbv3.StringValue(`"$user", public's\`)})
+			Expect(hpdbv3.FormatPostgresqlConf(&hpdbv3.Configuration{Configuration: item})).To(Equal("" +
+				"checkpoint_timeout = 2h\n" +
+				"deadlock_timeout = 1500ms\n" +
+				"search_path = '\"$user\", public''s\\\\'\n" +
+				"shared_buffers = 24kB\n" +
+				"statement_timeout = 0\n" +
+				"wal_segment_size = 32MB\n"))
+		})
+	})
+	Describe(`ParsePostgresqlConf(text string, current *Configuration)`, func() {
+		It(`Invoke ParsePostgresqlConf successfully`, func() {
+			configurations, err := hpdbv3.ParsePostgresqlConf(`
+# Reviewed settings
+
+shared_buffers = '256MB'	# (change requires restart)
+deadlock_timeout 2s
+work_mem = 8192
+MAX_CONNECTIONS=200
+max_connections = 300
+log_connections = on
+log_min_messages = error # errors only
+`, current)
+			Expect(err).To(BeNil())
+			Expect(configurations).To(Equal((&hpdbv3.Configurations{}).
+				SetParameter("shared_buffers", hpdbv3.IntegerValue(32768)).
+				SetParameter("deadlock_timeout", hpdbv3.IntegerValue(2000)).
+				SetParameter("work_mem", hpdbv3.IntegerValue(8192)).
+				SetParameter("max_connections", hpdbv3.IntegerValue(300)).
+				SetParameter("log_connections", hpdbv3.BoolValue(true)).
+				SetParameter("log_min_messages", hpdbv3.StringValue("error"))))
+			Expect(*configurations.SharedBuffers).To(Equal(int64(32768)))
+		})
+		It(`Invoke ParsePostgresqlConf successfully with the exported configuration`, func() {
+			configurations, err := hpdbv3.ParsePostgresqlConf(hpdbv3.FormatPostgresqlConf(current), current)
+			Expect(err).To(BeNil())
+			Expect(configurations.GetParameters()).To(HaveLen(8))
+			changes, err := hpdbv3.ValidateConfigurationChange(current, configurations)
+			Expect(err).To(BeNil())
+			Expect(changes).To(BeEmpty())
+
+			current.Configuration.SetParameter("search_path", &hpdbv3.Parameter{Type: hpdbv3.ParameterTypeString, Value: hpdbv3.StringValue(`"$user", public's\`)})
+			configurations, err = hpdbv3.ParsePostgresqlConf(hpdbv3.FormatPostgresqlConf(current), current)
+			Expect(err).To(BeNil())
+			Expect(configurations.Parameters["search_path"]).To(Equal(hpdbv3.StringValue(`"$user", public's\`)))
+
+			current.Configuration.SetParameter("search_path", &hpdbv3.Parameter{Type: hpdbv3.ParameterTypeString, Value: hpdbv3.StringValue("a\nb")})
+			configurations, err = hpdbv3.ParsePostgresqlConf(hpdbv3.FormatPostgresqlConf(current), current)
+			Expect(err).To(BeNil())
+			Expect(configurations.Parameters["search_path"]).To(Equal(hpdbv3.StringValue("a\nb")))
+		})
+		It(`Invoke ParsePostgresqlConf with error: Invalid text`, func() {
+			for text, message := range map[string]string{
+				"max_connections = 100\nno_such_parameter = 1": "line 2: unknown parameter no_such_parameter",
+				"shared_buffers = 1h":                          `line 1: invalid value of shared_buffers: "1h" has an invalid unit: valid units are TB, GB, MB, kB, B`,
+				"shared_buffers = 1kB":                         `line 1: invalid value of shared_buffers: "1kB" is not a multiple of 8kB`,
+				"shared_buffers = 128mb":                       `line 1: invalid value of shared_buffers: "128mb" has an invalid unit: valid units are TB, GB, MB, kB, B`,
+				"max_connections = 100MB":                      `line 1: invalid value of max_connections: "100MB" has a unit, but the parameter has none`,
+				"max_connections = many":                       `line 1: invalid value of max_connections: "many" is not an integer`,
+				"log_connections = maybe":                      `line 1: invalid value of log_connections: "maybe" is not a boolean`,
+				"log_min_messages = 'error":                    "line 1: syntax error: unterminated quoted value",
+				"max_connections = 100 200":                    `line 1: syntax error: unexpected "200" after the value of max_connections`,
+				"max_connections =":                            "line 1: syntax error: a value is expected for max_connections",
+				"= 100":                                        "line 1: syntax error: a parameter name is expected",
+				"include 'other.conf'":                         "line 1: include directives are not supported",
+			} {
+				_, err := hpdbv3.ParsePostgresqlConf(text, current)
+				Expect(err).ToNot(BeNil(), text)
+				Expect(err.Error()).To(Equal(message))
+			}
+		})
+		It(`Invoke ParsePostgresqlConf successfully with the exported configuration of the fake server`, func() {
+			// The fake server, like the service, does not report the units, so that those of ParameterUnits are used
+			server := hpdbv3test.NewServer()
+			defer server.Close()
+			server.AddCluster(hpdbv3test.NewCluster("9cebab98-afeb-4886-9a29-8e741716e7ff"))
+			hpdbService, err := server.NewClient()
+			Expect(err).To(BeNil())
+			exported, _, err := hpdbService.GetConfiguration(hpdbService.NewGetConfigurationOptions("9cebab98-afeb-4886-9a29-8e741716e7ff"))
+			Expect(err).To(BeNil())
+			Expect(exported.Configuration.GetParameter("shared_buffers").Unit).To(BeEmpty())
+
+			conf := hpdbv3.FormatPostgresqlConf(exported)
+			Expect(conf).To(ContainSubstring("shared_buffers = 128MB\t# (change requires restart)\n"))
+			Expect(conf).To(ContainSubstring("deadlock_timeout = 1s\n"))
+			Expect(conf).To(ContainSubstring("work_mem = 4MB\n"))
+			configurations, err := hpdbv3.ParsePostgresqlConf(conf, exported)
+			Expect(err).To(BeNil())
+			changes, err := hpdbv3.ValidateConfigurationChange(exported, configurations)
+			Expect(err).To(BeNil())
+			Expect(changes).To(BeEmpty())
+
+			// Plain integers are in the unit of the parameter
+			configurations, err = hpdbv3.ParsePostgresqlConf("shared_buffers = 32768\nshared_buffers = 128MB\ndeadlock_timeout = 1000", exported)
+			Expect(err).To(BeNil())
+			Expect(*configurations.SharedBuffers).To(Equal(int64(16384)))
+			Expect(*configurations.DeadlockTimeout).To(Equal(int64(1000)))
+		})
+		It(`Invoke ParsePostgresqlConf with error: Unknown current configuration`, func() {
+			_, err := hpdbv3.ParsePostgresqlConf("max_connections = 100", nil)
+			Expect(err).ToNot(BeNil())
+			_, err = hpdbv3.ParsePostgresqlConf("max_connections = 100", &hpdbv3.Configuration{})
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
@@ -0,0 +1,245 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Quantity : A size of memory or storage, in bytes.
+// Sizes are written by the service as a number followed by one of the units MB, MiB, GB, GiB, TB or TiB, such as 5GiB.
+// Since a Quantity is an integer number of bytes, quantities in different units can be compared and added directly.
+type Quantity int64
+
+// The units of a Quantity.
+const (
+	Byte Quantity = 1
+
+	KB Quantity = 1000 * Byte
+	MB Quantity = 1000 * KB
+	GB Quantity = 1000 * MB
+	TB Quantity = 1000 * GB
+
+	KiB Quantity = 1024 * Byte
+	MiB Quantity = 1024 * KiB
+	GiB Quantity = 1024 * MiB
+	TiB Quantity = 1024 * GiB
+)
+
+// quantityUnit is the name of a unit of a Quantity.
+type quantityUnit struct {
+	name string
+	size Quantity
+}
+
+// quantityUnits are the units of a Quantity which ParseQuantity accepts.
+var quantityUnits = []quantityUnit{
+	{"TiB", TiB},
+	{"GiB", GiB},
+	{"MiB", MiB},
+	{"KiB", KiB},
+	{"TB", TB},
+	{"GB", GB},
+	{"MB", MB},
+	{"KB", KB},
+	{"B", Byte},
+}
+
+// serviceUnits are the units of the sizes accepted by the service, in the order in which String tries them.
+var serviceUnits = []quantityUnit{
+	{"TiB", TiB},
+	{"GiB", GiB},
+	{"MiB", MiB},
+	{"TB", TB},
+	{"GB", GB},
+	{"MB", MB},
+}
+
+// ParseQuantity parses a size such as 5GiB, 512MB or 1.5 TiB. The unit is case-insensitive; a size without a unit is
+// in bytes. A fractional size is rounded to the nearest byte.
+func ParseQuantity(s string) (Quantity, error) {
+	value := strings.TrimSpace(s)
+	i := strings.IndexFunc(value, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	number, unitName := value, ""
+	if i >= 0 {
+		number, unitName = value[:i], strings.TrimSpace(value[i:])
+	}
+	if number == "" {
+		return 0, fmt.Errorf("invalid size %q: it must be a number followed by one of the units MB, MiB, GB, GiB, TB or TiB", s)
+	}
+	unit := Byte
+	if unitName != "" {
+		found := false
+		for _, u := range quantityUnits {
+			if strings.EqualFold(unitName, u.name) {
+				unit, found = u.size, true
+				break
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("invalid size %q: unknown unit %q", s, unitName)
+		}
+	}
+
+	if n, err := strconv.ParseInt(number, 10, 64); err == nil {
+		if n > math.MaxInt64/int64(unit) {
+			return 0, fmt.Errorf("invalid size %q: it is too large", s)
+		}
+		return Quantity(n) * unit, nil
+	}
+	f, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size %q: %q is not a number", s, number)
+	}
+	bytes := math.Round(f * float64(unit))
+	if bytes >= math.MaxInt64 {
+		return 0, fmt.Errorf("invalid size %q: it is too large", s)
+	}
+	return Quantity(bytes), nil
+}
+
+// Bytes returns the quantity in bytes.
+func (q Quantity) Bytes() int64 {
+	return int64(q)
+}
+
+// In returns the quantity as a number of the specified unit, such as GiB.
+func (q Quantity) In(unit Quantity) float64 {
+	return float64(q) / float64(unit)
+}
+
+// String formats the quantity in one of the units accepted by the service: the largest one which divides it exactly,
+// preferring the binary units, such as 5GiB or 500MB. A quantity which is not a whole number of MB or MiB is written as
+// a fractional number of MiB, such as 0.5MiB.
+func (q Quantity) String() string {
+	if q == 0 {
+		return "0MiB"
+	}
+	for _, u := range serviceUnits {
+		if q%u.size == 0 {
+			return strconv.FormatInt(int64(q/u.size), 10) + u.name
+		}
+	}
+	return strconv.FormatFloat(q.In(MiB), 'f', -1, 64) + "MiB"
+}
+
+// Format formats the quantity in the specified unit, such as 1.5GiB. It fails if the unit is not one of the units of
+// a Quantity.
+func (q Quantity) Format(unit Quantity) (string, error) {
+	for _, u := range quantityUnits {
+		if u.size == unit {
+			return strconv.FormatFloat(q.In(unit), 'f', -1, 64) + u.name, nil
+		}
+	}
+	return "", fmt.Errorf("invalid unit of %d bytes: it must be one of the units of a Quantity, such as GiB", int64(unit))
+}
+
+// Cmp compares two quantities. It returns -1 if q is smaller than other, 0 if they are equal and 1 if q is larger.
+func (q Quantity) Cmp(other Quantity) int {
+	switch {
+	case q < other:
+		return -1
+	case q > other:
+		return 1
+	}
+	return 0
+}
+
+// Add returns the sum of two quantities.
+func (q Quantity) Add(other Quantity) Quantity {
+	return q + other
+}
+
+// Sub returns the difference of two quantities.
+func (q Quantity) Sub(other Quantity) Quantity {
+	return q - other
+}
+
+// Mul returns the quantity multiplied by a factor, rounded to the nearest byte.
+func (q Quantity) Mul(factor float64) Quantity {
+	return Quantity(math.Round(float64(q) * factor))
+}
+
+// Ratio returns the quantity divided by another one, such as the used storage divided by the storage. It returns 0 if
+// the other quantity is 0.
+func (q Quantity) Ratio(other Quantity) float64 {
+	if other == 0 {
+		return 0
+	}
+	return float64(q) / float64(other)
+}
+
+// QuantityPtr returns a pointer to the string form of a quantity, in one of the units accepted by the service, which
+// can be used to set the Memory and Storage fields of Resources.
+func QuantityPtr(q Quantity) *string {
+	s := q.String()
+	return &s
+}
+
+// parseQuantityField parses an optional size. It returns 0 if the size is not set.
+func parseQuantityField(value *string) (Quantity, error) {
+	if value == nil || strings.TrimSpace(*value) == "" {
+		return 0, nil
+	}
+	return ParseQuantity(*value)
+}
+
+// GetMemory returns the size of the memory, or 0 if it is not set.
+func (resources *Resources) GetMemory() (Quantity, error) {
+	return parseQuantityField(resources.Memory)
+}
+
+// GetStorage returns the size of the storage, or 0 if it is not set.
+func (resources *Resources) GetStorage() (Quantity, error) {
+	return parseQuantityField(resources.Storage)
+}
+
+// GetMemory returns the size of the memory, or 0 if it is not set.
+func (clusterResource *ClusterResource) GetMemory() (Quantity, error) {
+	return parseQuantityField(clusterResource.Memory)
+}
+
+// GetStorage returns the size of the storage, or 0 if it is not set.
+func (clusterResource *ClusterResource) GetStorage() (Quantity, error) {
+	return parseQuantityField(clusterResource.Storage)
+}
+
+// GetStorageUsed returns the size of the used storage, or 0 if it is not set.
+func (clusterResource *ClusterResource) GetStorageUsed() (Quantity, error) {
+	return parseQuantityField(clusterResource.StorageUsed)
+}
+
+// StorageUtilization returns the fraction of the storage which is used, between 0 and 1.
+func (clusterResource *ClusterResource) StorageUtilization() (float64, error) {
+	storage, err := clusterResource.GetStorage()
+	if err != nil {
+		return 0, err
+	}
+	if storage == 0 {
+		return 0, fmt.Errorf("the storage size is not set")
+	}
+	used, err := clusterResource.GetStorageUsed()
+	if err != nil {
+		return 0, err
+	}
+	return used.Ratio(storage), nil
+}
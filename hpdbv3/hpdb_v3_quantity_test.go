@@ -0,0 +1,129 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3_test
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`HpdbV3 quantities`, func() {
+	Describe(`ParseQuantity(s string)`, func() {
+		It(`Invoke ParseQuantity successfully`, func() {
+			for s, expected := range map[string]hpdbv3.Quantity{
+				"5GiB":     5 * hpdbv3.GiB,
+				"5 GiB":    5 * hpdbv3.GiB,
+				"5gib":     5 * hpdbv3.GiB,
+				"1280GiB":  1280 * hpdbv3.GiB,
+				"512MB":    512 * hpdbv3.MB,
+				"512MiB":   512 * hpdbv3.MiB,
+				"2TB":      2 * hpdbv3.TB,
+				"1TiB":     1024 * hpdbv3.GiB,
+				"1.5GiB":   1536 * hpdbv3.MiB,
+				"0.25GB":   250 * hpdbv3.MB,
+				"1024":     hpdbv3.KiB,
+				"0":        0,
+				" 10GiB\n": 10 * hpdbv3.GiB,
+			} {
+				q, err := hpdbv3.ParseQuantity(s)
+				Expect(err).To(BeNil(), s)
+				Expect(q).To(Equal(expected), s)
+			}
+		})
+		It(`Invoke ParseQuantity with error: Invalid size`, func() {
+			for _, s := range []string{"", "GiB", "-5GiB", "5PiB", "5 G i B", "1.2.3GiB", "9223372036854775807GiB", "1e30GiB", "testString"} {
+				_, err := hpdbv3.ParseQuantity(s)
+				Expect(err).ToNot(BeNil(), s)
+			}
+		})
+	})
+	Describe(`Quantity`, func() {
+		It(`Format quantities successfully`, func() {
+			Expect((5 * hpdbv3.GiB).String()).To(Equal("5GiB"))
+			Expect((2048 * hpdbv3.GiB).String()).To(Equal("2TiB"))
+			Expect((1536 * hpdbv3.MiB).String()).To(Equal("1536MiB"))
+			Expect((500 * hpdbv3.MB).String()).To(Equal("500MB"))
+			Expect((2 * hpdbv3.KiB).String()).To(Equal("0.001953125MiB"))
+			Expect((512 * hpdbv3.KiB).String()).To(Equal("0.5MiB"))
+			Expect(hpdbv3.Quantity(0).String()).To(Equal("0MiB"))
+			Expect((-3 * hpdbv3.GiB).String()).To(Equal("-3GiB"))
+
+			formatted, err := (1536 * hpdbv3.MiB).Format(hpdbv3.GiB)
+			Expect(err).To(BeNil())
+			Expect(formatted).To(Equal("1.5GiB"))
+			formatted, err = (5 * hpdbv3.GiB).Format(hpdbv3.GB)
+			Expect(err).To(BeNil())
+			Expect(formatted).To(Equal("5.36870912GB"))
+			_, err = hpdbv3.GiB.Format(3)
+			Expect(err).ToNot(BeNil())
+
+			Expect(*hpdbv3.QuantityPtr(16 * hpdbv3.GiB)).To(Equal("16GiB"))
+		})
+		It(`Compute with quantities successfully`, func() {
+			Expect(hpdbv3.GiB.Cmp(hpdbv3.GB)).To(Equal(1))
+			Expect(hpdbv3.GB.Cmp(hpdbv3.GiB)).To(Equal(-1))
+			Expect((1000 * hpdbv3.MB).Cmp(hpdbv3.GB)).To(Equal(0))
+
+			Expect(hpdbv3.GiB.Add(512 * hpdbv3.MiB)).To(Equal(1536 * hpdbv3.MiB))
+			Expect(hpdbv3.GiB.Sub(hpdbv3.GB)).To(Equal(hpdbv3.Quantity(73741824)))
+			Expect((10 * hpdbv3.GiB).Mul(1.5)).To(Equal(15 * hpdbv3.GiB))
+			Expect((5 * hpdbv3.GiB).Ratio(20 * hpdbv3.GiB)).To(Equal(0.25))
+			Expect(hpdbv3.GiB.Ratio(0)).To(Equal(float64(0)))
+			Expect((1536 * hpdbv3.MiB).In(hpdbv3.GiB)).To(Equal(1.5))
+			Expect(hpdbv3.KiB.Bytes()).To(Equal(int64(1024)))
+		})
+	})
+	Describe(`Quantity accessors`, func() {
+		It(`Invoke StorageUtilization successfully`, func() {
+			resource := &hpdbv3.ClusterResource{
+				Cpu:         core.Int64Ptr(2),
+				Memory:      core.StringPtr("4GiB"),
+				Storage:     core.StringPtr("10GiB"),
+				StorageUsed: core.StringPtr("2560MiB"),
+			}
+			memory, err := resource.GetMemory()
+			Expect(err).To(BeNil())
+			Expect(memory).To(Equal(4 * hpdbv3.GiB))
+			utilization, err := resource.StorageUtilization()
+			Expect(err).To(BeNil())
+			Expect(utilization).To(Equal(0.25))
+
+			// The used storage is 0 if it is not set
+			resource.StorageUsed = nil
+			utilization, err = resource.StorageUtilization()
+			Expect(err).To(BeNil())
+			Expect(utilization).To(Equal(float64(0)))
+		})
+		It(`Invoke StorageUtilization with error: Invalid sizes`, func() {
+			_, err := (&hpdbv3.ClusterResource{StorageUsed: core.StringPtr("1GiB")}).StorageUtilization()
+			Expect(err).ToNot(BeNil())
+			_, err = (&hpdbv3.ClusterResource{Storage: core.StringPtr("10GiB"), StorageUsed: core.StringPtr("StorageUsed")}).StorageUtilization()
+			Expect(err).ToNot(BeNil())
+		})
+		It(`Invoke the accessors of Resources successfully`, func() {
+			resources := &hpdbv3.Resources{Memory: hpdbv3.QuantityPtr(8 * hpdbv3.GiB)}
+			memory, err := resources.GetMemory()
+			Expect(err).To(BeNil())
+			Expect(memory).To(Equal(8 * hpdbv3.GiB))
+			storage, err := resources.GetStorage()
+			Expect(err).To(BeNil())
+			Expect(storage).To(BeZero())
+		})
+	})
+})
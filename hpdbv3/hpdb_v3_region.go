@@ -0,0 +1,156 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// RegionEndpoints : The endpoints of the service in a region.
+type RegionEndpoints struct {
+	// The public endpoint, such as dbaas902.hyperp-dbaas.cloud.ibm.com:20000.
+	Public string `json:"public"`
+
+	// The private endpoint, which is reachable from the IBM Cloud private network.
+	Private string `json:"private,omitempty"`
+}
+
+// defaultRegionEndpoints is the built-in region catalog. It only holds the endpoint documented with the service, the
+// one of the eu-de cluster in the README sample. The endpoints of the other regions, and the private endpoints, are
+// set with SetRegionEndpoints or LoadRegionCatalogFile.
+var defaultRegionEndpoints = map[string]RegionEndpoints{
+	"eu-de": {Public: "dbaas902.hyperp-dbaas.cloud.ibm.com:20000"},
+}
+
+// regionCatalog holds the region catalog, which starts as a copy of the built-in one.
+var regionCatalog = struct {
+	sync.RWMutex
+	regions map[string]RegionEndpoints
+}{regions: copyRegionEndpoints(defaultRegionEndpoints)}
+
+func copyRegionEndpoints(regions map[string]RegionEndpoints) map[string]RegionEndpoints {
+	copied := make(map[string]RegionEndpoints, len(regions))
+	for region, endpoints := range regions {
+		copied[region] = endpoints
+	}
+	return copied
+}
+
+// GetServiceURLForRegion returns the service URL to be used for the specified region
+func GetServiceURLForRegion(region string) (string, error) {
+	endpoints, err := GetEndpointsForRegion(region)
+	if err != nil {
+		return "", err
+	}
+	return ConstructServiceURL(map[string]string{"endpoint": endpoints.Public})
+}
+
+// GetPrivateServiceURLForRegion returns the service URL to be used for the specified region from the IBM Cloud private
+// network.
+func GetPrivateServiceURLForRegion(region string) (string, error) {
+	endpoints, err := GetEndpointsForRegion(region)
+	if err != nil {
+		return "", err
+	}
+	if endpoints.Private == "" {
+		return "", fmt.Errorf("region %q does not have a private endpoint", region)
+	}
+	return ConstructServiceURL(map[string]string{"endpoint": endpoints.Private})
+}
+
+// GetEndpointsForRegion returns the endpoints of the service in the specified region, such as eu-de.
+func GetEndpointsForRegion(region string) (*RegionEndpoints, error) {
+	regionCatalog.RLock()
+	defer regionCatalog.RUnlock()
+	endpoints, ok := regionCatalog.regions[region]
+	if !ok {
+		regions := make([]string, 0, len(regionCatalog.regions))
+		for name := range regionCatalog.regions {
+			regions = append(regions, name)
+		}
+		sort.Strings(regions)
+		return nil, fmt.Errorf("service does not support region %q; supported regions are %s", region, strings.Join(regions, ", "))
+	}
+	return &endpoints, nil
+}
+
+// SetRegionEndpoints adds a region to the region catalog, or replaces the endpoints of a region.
+func SetRegionEndpoints(region string, endpoints RegionEndpoints) error {
+	if region == "" {
+		return fmt.Errorf("region cannot be empty")
+	}
+	endpoints.Public = normalizeEndpoint(endpoints.Public)
+	endpoints.Private = normalizeEndpoint(endpoints.Private)
+	if endpoints.Public == "" {
+		return fmt.Errorf("the public endpoint of region %q cannot be empty", region)
+	}
+	regionCatalog.Lock()
+	defer regionCatalog.Unlock()
+	regionCatalog.regions[region] = endpoints
+	return nil
+}
+
+// LoadRegionCatalogFile overrides the region catalog with the regions in a JSON file, such as:
+//
+//	{
+//	  "eu-de": {
+//	    "public": "dbaas902.hyperp-dbaas.cloud.ibm.com:20000",
+//	    "private": "dbaas902.private.hyperp-dbaas.cloud.ibm.com:20000"
+//	  }
+//	}
+//
+// The regions which are not in the file keep their endpoints. Nothing is changed if the file is not valid.
+func LoadRegionCatalogFile(path string) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var regions map[string]RegionEndpoints
+	if err = json.Unmarshal(b, &regions); err != nil {
+		return fmt.Errorf("error parsing region catalog %s: %s", path, err.Error())
+	}
+	for region, endpoints := range regions {
+		if region == "" || normalizeEndpoint(endpoints.Public) == "" {
+			return fmt.Errorf("error parsing region catalog %s: region %q does not have a public endpoint", path, region)
+		}
+	}
+	for region, endpoints := range regions {
+		if err = SetRegionEndpoints(region, endpoints); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ResetRegionCatalog restores the built-in region catalog, discarding the regions set by SetRegionEndpoints and
+// LoadRegionCatalogFile.
+func ResetRegionCatalog() {
+	regionCatalog.Lock()
+	defer regionCatalog.Unlock()
+	regionCatalog.regions = copyRegionEndpoints(defaultRegionEndpoints)
+}
+
+// normalizeEndpoint removes the scheme and the trailing slash of an endpoint, so that it can be used as the endpoint
+// variable of ParameterizedServiceURL.
+func normalizeEndpoint(endpoint string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(endpoint), "https://"), "/")
+}
@@ -0,0 +1,136 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`HpdbV3 regions`, func() {
+	AfterEach(func() {
+		hpdbv3.ResetRegionCatalog()
+	})
+
+	Describe(`GetServiceURLForRegion(region string)`, func() {
+		It(`Invoke GetServiceURLForRegion successfully`, func() {
+			url, err := hpdbv3.GetServiceURLForRegion("eu-de")
+			Expect(err).To(BeNil())
+			Expect(url).To(Equal("https://dbaas902.hyperp-dbaas.cloud.ibm.com:20000/api/v3/unknown"))
+		})
+		It(`Invoke GetServiceURLForRegion with error: Unknown region`, func() {
+			url, err := hpdbv3.GetServiceURLForRegion("us-east")
+			Expect(url).To(BeEmpty())
+			Expect(err).ToNot(BeNil())
+
+			// The endpoint of us-south is not documented, so it is not built in
+			url, err = hpdbv3.GetServiceURLForRegion("us-south")
+			Expect(url).To(BeEmpty())
+			Expect(err).ToNot(BeNil())
+		})
+		It(`Invoke GetPrivateServiceURLForRegion successfully`, func() {
+			// The private endpoints are not built in
+			url, err := hpdbv3.GetPrivateServiceURLForRegion("eu-de")
+			Expect(url).To(BeEmpty())
+			Expect(err).ToNot(BeNil())
+
+			Expect(hpdbv3.SetRegionEndpoints("eu-de", hpdbv3.RegionEndpoints{
+				Public:  "dbaas902.hyperp-dbaas.cloud.ibm.com:20000",
+				Private: "dbaas912.private.example.com:20000",
+			})).To(Succeed())
+			url, err = hpdbv3.GetPrivateServiceURLForRegion("eu-de")
+			Expect(err).To(BeNil())
+			Expect(url).To(Equal("https://dbaas912.private.example.com:20000/api/v3/unknown"))
+
+			url, err = hpdbv3.GetPrivateServiceURLForRegion("INVALID_REGION")
+			Expect(url).To(BeEmpty())
+			Expect(err).ToNot(BeNil())
+		})
+	})
+	Describe(`SetRegionEndpoints(region string, endpoints RegionEndpoints)`, func() {
+		It(`Invoke SetRegionEndpoints successfully`, func() {
+			err := hpdbv3.SetRegionEndpoints("eu-gb", hpdbv3.RegionEndpoints{Public: "https://dbaas999.example.com:20000/"})
+			Expect(err).To(BeNil())
+
+			endpoints, err := hpdbv3.GetEndpointsForRegion("eu-gb")
+			Expect(err).To(BeNil())
+			Expect(endpoints.Public).To(Equal("dbaas999.example.com:20000"))
+
+			url, err := hpdbv3.GetPrivateServiceURLForRegion("eu-gb")
+			Expect(url).To(BeEmpty())
+			Expect(err).ToNot(BeNil())
+
+			hpdbv3.ResetRegionCatalog()
+			_, err = hpdbv3.GetEndpointsForRegion("eu-gb")
+			Expect(err).ToNot(BeNil())
+		})
+		It(`Invoke SetRegionEndpoints with error: Missing public endpoint`, func() {
+			Expect(hpdbv3.SetRegionEndpoints("eu-gb", hpdbv3.RegionEndpoints{Private: "dbaas999.example.com:20000"})).ToNot(BeNil())
+			Expect(hpdbv3.SetRegionEndpoints("", hpdbv3.RegionEndpoints{Public: "dbaas999.example.com:20000"})).ToNot(BeNil())
+		})
+	})
+	Describe(`LoadRegionCatalogFile(path string)`, func() {
+		var dir string
+		BeforeEach(func() {
+			var err error
+			dir, err = os.MkdirTemp("", "regions")
+			Expect(err).To(BeNil())
+		})
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+		var writeFile = func(content string) string {
+			path := filepath.Join(dir, "regions.json")
+			Expect(os.WriteFile(path, []byte(content), 0600)).To(Succeed())
+			return path
+		}
+		It(`Invoke LoadRegionCatalogFile successfully`, func() {
+			path := writeFile(`{
+				"eu-de": {"public": "dbaas912.example.com:20000", "private": "dbaas912.private.example.com:20000"},
+				"jp-tok": {"public": "dbaas913.example.com:20000"}
+			}`)
+			Expect(hpdbv3.SetRegionEndpoints("us-south", hpdbv3.RegionEndpoints{Public: "dbaas911.example.com:20000"})).To(Succeed())
+			Expect(hpdbv3.LoadRegionCatalogFile(path)).To(Succeed())
+
+			url, err := hpdbv3.GetServiceURLForRegion("eu-de")
+			Expect(err).To(BeNil())
+			Expect(url).To(Equal("https://dbaas912.example.com:20000/api/v3/unknown"))
+			url, err = hpdbv3.GetServiceURLForRegion("jp-tok")
+			Expect(err).To(BeNil())
+			Expect(url).To(Equal("https://dbaas913.example.com:20000/api/v3/unknown"))
+
+			// The regions which are not in the file are kept
+			url, err = hpdbv3.GetServiceURLForRegion("us-south")
+			Expect(err).To(BeNil())
+			Expect(url).To(Equal("https://dbaas911.example.com:20000/api/v3/unknown"))
+		})
+		It(`Invoke LoadRegionCatalogFile with error: Invalid file`, func() {
+			Expect(hpdbv3.LoadRegionCatalogFile(filepath.Join(dir, "missing.json"))).ToNot(Succeed())
+			Expect(hpdbv3.LoadRegionCatalogFile(writeFile(`{"eu-de": "dbaas912.example.com"}`))).ToNot(Succeed())
+
+			// Nothing is changed if one of the regions is not valid
+			Expect(hpdbv3.LoadRegionCatalogFile(writeFile(`{"eu-de": {"public": "dbaas912.example.com:20000"}, "jp-tok": {}}`))).ToNot(Succeed())
+			url, err := hpdbv3.GetServiceURLForRegion("eu-de")
+			Expect(err).To(BeNil())
+			Expect(url).To(Equal("https://dbaas902.hyperp-dbaas.cloud.ibm.com:20000/api/v3/unknown"))
+		})
+	})
+})
@@ -134,7 +134,8 @@ var _ = Describe(`HpdbV3 Resource Scaling Tests`, func() {
 				clusterId,
 			)
 			memory := "2gib"
-			storage := "5gib"
+			// The storage cannot shrink
+			storage := *cluster.Resource.Storage
 
 			var resource hpdbv3.Resources
 			resource.Cpu = &cpuNumber
@@ -0,0 +1,254 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// AllowedCpus are the numbers of CPUs which a cluster can be scaled to.
+var AllowedCpus = []int64{1, 2, 3, 4, 5, 6, 8, 9, 12, 16}
+
+// AllowedMemorySizes are the sizes of memory which a cluster can be scaled to.
+var AllowedMemorySizes = []Quantity{
+	2 * GiB, 3 * GiB, 4 * GiB, 5 * GiB, 8 * GiB, 12 * GiB, 16 * GiB, 24 * GiB, 32 * GiB, 64 * GiB, 96 * GiB, 128 * GiB,
+}
+
+// AllowedStorageSizes are the sizes of storage which a cluster can be scaled to.
+var AllowedStorageSizes = []Quantity{
+	5 * GiB, 10 * GiB, 16 * GiB, 24 * GiB, 32 * GiB, 64 * GiB, 128 * GiB, 160 * GiB, 256 * GiB, 512 * GiB, 640 * GiB,
+	1280 * GiB,
+}
+
+// ResourceValidationError : The error returned when the resources requested by ScaleResources are not valid.
+type ResourceValidationError struct {
+	// The invalid resource: cpu, memory or storage.
+	Resource string
+
+	// The requested value.
+	Value string
+
+	// Why the value is not valid.
+	Reason string
+
+	// The nearest valid value, if there is one.
+	Suggestion string
+}
+
+// Error returns the error message.
+func (e *ResourceValidationError) Error() string {
+	msg := fmt.Sprintf("invalid %s %s: %s", e.Resource, e.Value, e.Reason)
+	if e.Suggestion != "" {
+		msg += fmt.Sprintf("; the nearest valid value is %s", e.Suggestion)
+	}
+	return msg
+}
+
+// ValidateResources checks that the resources requested by ScaleResources are in the allowed tiers: AllowedCpus,
+// AllowedMemorySizes and AllowedStorageSizes. If the current resources of the cluster are specified, it also checks that
+// the storage is not reduced, since the storage of a cluster cannot shrink. The error is a *ResourceValidationError which
+// suggests the nearest valid value. A size equal to an allowed size is valid in any unit, such as 2048MiB for 2GiB:
+// ScaleResources sends it in the canonical form of the allowed size.
+func ValidateResources(resources *Resources, current *ClusterResource) error {
+	if resources == nil {
+		return nil
+	}
+	if resources.Cpu != nil {
+		cpu := *resources.Cpu
+		if !isAllowedCpu(cpu) {
+			suggestion := nearestCpu(cpu)
+			return &ResourceValidationError{
+				Resource:   "cpu",
+				Value:      strconv.FormatInt(cpu, 10),
+				Reason:     "allowed values are " + joinCpus(AllowedCpus),
+				Suggestion: strconv.FormatInt(suggestion, 10),
+			}
+		}
+	}
+	if resources.Memory != nil {
+		if err := validateQuantity("memory", *resources.Memory, AllowedMemorySizes, 0); err != nil {
+			return err
+		}
+	}
+	if resources.Storage != nil {
+		var minimum Quantity
+		if current != nil {
+			currentStorage, err := current.GetStorage()
+			if err != nil {
+				return fmt.Errorf("error parsing the current storage of the cluster: %s", err.Error())
+			}
+			minimum = currentStorage
+		}
+		if err := validateQuantity("storage", *resources.Storage, AllowedStorageSizes, minimum); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EnableResourceValidation enables the validation of the resources requested by ScaleResources with ValidateResources.
+// When the storage is scaled, it gets the cluster before the update to check that its storage does not shrink. It is
+// disabled by default.
+func (hpdb *HpdbV3) EnableResourceValidation() {
+	hpdb.validateResources = true
+}
+
+// DisableResourceValidation disables the validation of the resources requested by ScaleResources, so that they are
+// sent to the service as is.
+func (hpdb *HpdbV3) DisableResourceValidation() {
+	hpdb.validateResources = false
+}
+
+// validateScaleResources validates the resources requested by ScaleResources, and returns a copy of the options with
+// the sizes in their canonical form. When the storage is scaled, the cluster is fetched to check that its storage does
+// not shrink, and the response is the one of GetCluster if that fails.
+func (hpdb *HpdbV3) validateScaleResources(ctx context.Context, scaleResourcesOptions *ScaleResourcesOptions) (options *ScaleResourcesOptions, response *core.DetailedResponse, err error) {
+	resources := scaleResourcesOptions.Resource
+	err = ValidateResources(resources, nil)
+	if err != nil {
+		return
+	}
+	if resources != nil && resources.Storage != nil {
+		getClusterOptions := hpdb.NewGetClusterOptions(*scaleResourcesOptions.ClusterID)
+		getClusterOptions.SetHeaders(scaleResourcesOptions.Headers)
+		var cluster *Cluster
+		cluster, response, err = hpdb.GetClusterWithContext(ctx, getClusterOptions)
+		if err != nil {
+			return
+		}
+		response = nil
+		if err = ValidateResources(resources, cluster.Resource); err != nil {
+			return
+		}
+	}
+
+	options = scaleResourcesOptions
+	if resources != nil {
+		copied := *scaleResourcesOptions
+		copied.Resource = canonicalResources(resources)
+		options = &copied
+	}
+	return
+}
+
+// canonicalResources returns a copy of valid resources with the sizes written as the allowed sizes, such as 2GiB for
+// 2048MiB.
+func canonicalResources(resources *Resources) *Resources {
+	canonical := *resources
+	if memory, err := resources.GetMemory(); resources.Memory != nil && err == nil {
+		canonical.Memory = QuantityPtr(memory)
+	}
+	if storage, err := resources.GetStorage(); resources.Storage != nil && err == nil {
+		canonical.Storage = QuantityPtr(storage)
+	}
+	return &canonical
+}
+
+// validateQuantity checks that a size is one of the allowed sizes and that it is not smaller than the minimum.
+func validateQuantity(resource string, value string, allowed []Quantity, minimum Quantity) error {
+	q, err := ParseQuantity(value)
+	if err != nil {
+		return &ResourceValidationError{Resource: resource, Value: value, Reason: err.Error()}
+	}
+	if q < minimum {
+		e := &ResourceValidationError{
+			Resource: resource,
+			Value:    value,
+			Reason:   fmt.Sprintf("the %s cannot be reduced below its current size %s", resource, minimum),
+		}
+		if suggestion, ok := nearestQuantity(minimum, allowed, minimum); ok {
+			e.Suggestion = suggestion.String()
+		}
+		return e
+	}
+	for _, a := range allowed {
+		if q == a {
+			return nil
+		}
+	}
+	e := &ResourceValidationError{
+		Resource: resource,
+		Value:    value,
+		Reason:   "allowed values are " + joinQuantities(allowed),
+	}
+	if suggestion, ok := nearestQuantity(q, allowed, minimum); ok {
+		e.Suggestion = suggestion.String()
+	}
+	return e
+}
+
+func isAllowedCpu(cpu int64) bool {
+	for _, a := range AllowedCpus {
+		if cpu == a {
+			return true
+		}
+	}
+	return false
+}
+
+// nearestCpu returns the allowed number of CPUs which is the nearest to cpu, preferring the larger one on a tie.
+func nearestCpu(cpu int64) int64 {
+	nearest := AllowedCpus[0]
+	for _, a := range AllowedCpus {
+		if distance(a, cpu) <= distance(nearest, cpu) {
+			nearest = a
+		}
+	}
+	return nearest
+}
+
+// nearestQuantity returns the allowed size which is the nearest to q and is not smaller than the minimum, preferring
+// the larger one on a tie. It returns false if all the allowed sizes are smaller than the minimum.
+func nearestQuantity(q Quantity, allowed []Quantity, minimum Quantity) (nearest Quantity, ok bool) {
+	for _, a := range allowed {
+		if a < minimum {
+			continue
+		}
+		if !ok || distance(int64(a), int64(q)) <= distance(int64(nearest), int64(q)) {
+			nearest, ok = a, true
+		}
+	}
+	return
+}
+
+func distance(a int64, b int64) int64 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+func joinCpus(cpus []int64) string {
+	s := make([]string, len(cpus))
+	for i, cpu := range cpus {
+		s[i] = strconv.FormatInt(cpu, 10)
+	}
+	return strings.Join(s, ", ")
+}
+
+func joinQuantities(quantities []Quantity) string {
+	s := make([]string, len(quantities))
+	for i, q := range quantities {
+		s[i] = q.String()
+	}
+	return strings.Join(s, ", ")
+}
@@ -0,0 +1,223 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`HpdbV3 resource validation`, func() {
+	Describe(`ValidateResources(resources *Resources, current *ClusterResource)`, func() {
+		current := &hpdbv3.ClusterResource{
+			Cpu:     core.Int64Ptr(2),
+			Memory:  core.StringPtr("4GiB"),
+			Storage: core.StringPtr("24GiB"),
+		}
+		var validationError = func(err error) *hpdbv3.ResourceValidationError {
+			Expect(err).ToNot(BeNil())
+			e, ok := err.(*hpdbv3.ResourceValidationError)
+			Expect(ok).To(BeTrue(), err.Error())
+			return e
+		}
+		It(`Invoke ValidateResources successfully`, func() {
+			Expect(hpdbv3.ValidateResources(nil, nil)).To(Succeed())
+			Expect(hpdbv3.ValidateResources(&hpdbv3.Resources{}, current)).To(Succeed())
+			Expect(hpdbv3.ValidateResources(&hpdbv3.Resources{
+				Cpu:     core.Int64Ptr(16),
+				Memory:  core.StringPtr("128GiB"),
+				Storage: core.StringPtr("1280GiB"),
+			}, current)).To(Succeed())
+
+			// The sizes may be written in any unit, and the storage may keep its size
+			Expect(hpdbv3.ValidateResources(&hpdbv3.Resources{
+				Memory:  core.StringPtr("2048mib"),
+				Storage: core.StringPtr("24GiB"),
+			}, current)).To(Succeed())
+		})
+		It(`Invoke ValidateResources with error: Invalid CPU`, func() {
+			e := validationError(hpdbv3.ValidateResources(&hpdbv3.Resources{Cpu: core.Int64Ptr(7)}, nil))
+			Expect(e.Resource).To(Equal("cpu"))
+			Expect(e.Suggestion).To(Equal("8"))
+			Expect(e.Error()).To(Equal("invalid cpu 7: allowed values are 1, 2, 3, 4, 5, 6, 8, 9, 12, 16; the nearest valid value is 8"))
+
+			Expect(validationError(hpdbv3.ValidateResources(&hpdbv3.Resources{Cpu: core.Int64Ptr(38)}, nil)).Suggestion).To(Equal("16"))
+			Expect(validationError(hpdbv3.ValidateResources(&hpdbv3.Resources{Cpu: core.Int64Ptr(0)}, nil)).Suggestion).To(Equal("1"))
+		})
+		It(`Invoke ValidateResources with error: Invalid memory`, func() {
+			e := validationError(hpdbv3.ValidateResources(&hpdbv3.Resources{Memory: core.StringPtr("7GiB")}, current))
+			Expect(e.Resource).To(Equal("memory"))
+			Expect(e.Suggestion).To(Equal("8GiB"))
+
+			e = validationError(hpdbv3.ValidateResources(&hpdbv3.Resources{Memory: core.StringPtr("1000GB")}, current))
+			Expect(e.Suggestion).To(Equal("128GiB"))
+
+			e = validationError(hpdbv3.ValidateResources(&hpdbv3.Resources{Memory: core.StringPtr("testString")}, current))
+			Expect(e.Value).To(Equal("testString"))
+			Expect(e.Suggestion).To(BeEmpty())
+		})
+		It(`Invoke ValidateResources with error: Invalid storage`, func() {
+			e := validationError(hpdbv3.ValidateResources(&hpdbv3.Resources{Storage: core.StringPtr("300GiB")}, nil))
+			Expect(e.Resource).To(Equal("storage"))
+			Expect(e.Suggestion).To(Equal("256GiB"))
+
+			// The storage cannot shrink
+			e = validationError(hpdbv3.ValidateResources(&hpdbv3.Resources{Storage: core.StringPtr("16GiB")}, current))
+			Expect(e.Suggestion).To(Equal("24GiB"))
+			Expect(e.Error()).To(ContainSubstring("cannot be reduced below its current size 24GiB"))
+
+			// The nearest valid value is not smaller than the current storage
+			e = validationError(hpdbv3.ValidateResources(&hpdbv3.Resources{Storage: core.StringPtr("30GiB")}, &hpdbv3.ClusterResource{Storage: core.StringPtr("30GiB")}))
+			Expect(e.Suggestion).To(Equal("32GiB"))
+			e = validationError(hpdbv3.ValidateResources(&hpdbv3.Resources{Storage: core.StringPtr("20GiB")}, current))
+			Expect(e.Suggestion).To(Equal("24GiB"))
+
+			Expect(hpdbv3.ValidateResources(&hpdbv3.Resources{Storage: core.StringPtr("32GiB")}, &hpdbv3.ClusterResource{Storage: core.StringPtr("Storage")})).ToNot(Succeed())
+		})
+	})
+	Describe(`ScaleResources(scaleResourcesOptions *ScaleResourcesOptions) with resource validation`, func() {
+		var testServer *httptest.Server
+		var requests []string
+		var bodies []map[string]interface{}
+		clusterID := "9cebab98-afeb-4886-9a29-8e741716e7ff"
+
+		BeforeEach(func() {
+			requests = nil
+			bodies = nil
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				requests = append(requests, req.Method+" "+req.URL.EscapedPath())
+				Expect(req.Header.Get("x-custom-header")).To(Equal("x-custom-value"))
+				res.Header().Set("Content-type", "application/json")
+				switch req.Method + " " + req.URL.EscapedPath() {
+				case "GET /clusters/" + clusterID:
+					res.WriteHeader(200)
+					fmt.Fprint(res, `{"id": "9cebab98-afeb-4886-9a29-8e741716e7ff", "resource": {"cpu": 2, "memory": "4GiB", "storage": "24GiB"}}`)
+				case "PATCH /clusters/" + clusterID + "/resource":
+					var body map[string]interface{}
+					Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+					bodies = append(bodies, body)
+					res.WriteHeader(202)
+					fmt.Fprint(res, `{"task_id": "TaskID"}`)
+				default:
+					Fail("unexpected request: " + req.Method + " " + req.URL.EscapedPath())
+				}
+			}))
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+		var newService = func() *hpdbv3.HpdbV3 {
+			hpdbService, serviceErr := hpdbv3.NewHpdbV3(&hpdbv3.HpdbV3Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+			hpdbService.EnableResourceValidation()
+			return hpdbService
+		}
+		var newOptions = func(resources *hpdbv3.Resources) *hpdbv3.ScaleResourcesOptions {
+			return new(hpdbv3.ScaleResourcesOptions).
+				SetClusterID(clusterID).
+				SetResource(resources).
+				SetHeaders(map[string]string{"x-custom-header": "x-custom-value"})
+		}
+		It(`Invoke ScaleResources successfully`, func() {
+			hpdbService := newService()
+
+			// The cluster is only fetched when the storage is scaled
+			result, response, err := hpdbService.ScaleResources(newOptions(&hpdbv3.Resources{Cpu: core.Int64Ptr(4)}))
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(202))
+			Expect(*result.TaskID).To(Equal("TaskID"))
+			Expect(requests).To(Equal([]string{"PATCH /clusters/" + clusterID + "/resource"}))
+
+			requests = nil
+			_, response, err = hpdbService.ScaleResources(newOptions(&hpdbv3.Resources{Storage: core.StringPtr("32GiB")}))
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(202))
+			Expect(requests).To(Equal([]string{"GET /clusters/" + clusterID, "PATCH /clusters/" + clusterID + "/resource"}))
+		})
+		It(`Invoke ScaleResources successfully with sizes in a non-canonical form`, func() {
+			hpdbService := newService()
+
+			options := newOptions(&hpdbv3.Resources{Memory: core.StringPtr("2048mib"), Storage: core.StringPtr("32768MiB")})
+			_, response, err := hpdbService.ScaleResources(options)
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(202))
+			Expect(bodies).To(HaveLen(1))
+			Expect(bodies[0]).To(Equal(map[string]interface{}{"resource": map[string]interface{}{"memory": "2GiB", "storage": "32GiB"}}))
+
+			// The options of the caller are not changed
+			Expect(*options.Resource.Memory).To(Equal("2048mib"))
+			Expect(*options.Resource.Storage).To(Equal("32768MiB"))
+		})
+		It(`Invoke ScaleResources with error: Invalid resources`, func() {
+			hpdbService := newService()
+
+			result, response, err := hpdbService.ScaleResources(newOptions(&hpdbv3.Resources{Cpu: core.Int64Ptr(7)}))
+			Expect(err).To(BeAssignableToTypeOf(&hpdbv3.ResourceValidationError{}))
+			Expect(response).To(BeNil())
+			Expect(result).To(BeNil())
+			Expect(requests).To(BeEmpty())
+
+			_, _, err = hpdbService.ScaleResources(newOptions(&hpdbv3.Resources{Storage: core.StringPtr("16GiB")}))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("the nearest valid value is 24GiB"))
+			Expect(requests).To(Equal([]string{"GET /clusters/" + clusterID}))
+		})
+		It(`Invoke ScaleResources successfully without enabling resource validation`, func() {
+			hpdbService, serviceErr := hpdbv3.NewHpdbV3(&hpdbv3.HpdbV3Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+
+			// The resources are sent as is, without getting the cluster
+			_, response, err := hpdbService.ScaleResources(newOptions(&hpdbv3.Resources{Storage: core.StringPtr("32768MiB")}))
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(202))
+			Expect(requests).To(Equal([]string{"PATCH /clusters/" + clusterID + "/resource"}))
+			Expect(bodies[0]).To(Equal(map[string]interface{}{"resource": map[string]interface{}{"storage": "32768MiB"}}))
+		})
+		It(`Invoke ScaleResources successfully with resource validation disabled`, func() {
+			hpdbService := newService()
+			hpdbService.DisableResourceValidation()
+
+			_, response, err := hpdbService.ScaleResources(newOptions(&hpdbv3.Resources{Cpu: core.Int64Ptr(7), Storage: core.StringPtr("16GiB")}))
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(202))
+			Expect(requests).To(Equal([]string{"PATCH /clusters/" + clusterID + "/resource"}))
+
+			// The setting is kept by Clone
+			_, _, err = hpdbService.Clone().ScaleResources(newOptions(&hpdbv3.Resources{Cpu: core.Int64Ptr(7)}))
+			Expect(err).To(BeNil())
+
+			hpdbService.EnableResourceValidation()
+			_, _, err = hpdbService.ScaleResources(newOptions(&hpdbv3.Resources{Cpu: core.Int64Ptr(7)}))
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
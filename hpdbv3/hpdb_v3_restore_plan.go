@@ -0,0 +1,229 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// RestorePlanner : Computes the plan to restore a cluster to a point in time, and applies it. Plan selects the most
+// recent backup created at or before that time without changing anything, so that the plan can be reviewed or approved
+// before it is applied with RestorePlan.Apply.
+type RestorePlanner struct {
+	hpdb        *HpdbV3
+	clusterID   string
+	pointInTime time.Time
+	options     RestoreOptions
+	backupFiles BackupFiles
+}
+
+// NewRestorePlanner returns a RestorePlanner which restores the cluster to the specified point in time, from the backups
+// of the default source. Set the source type to cos, the COS parameters and the backup files in the bucket to restore
+// from a backup file in COS.
+func (hpdb *HpdbV3) NewRestorePlanner(clusterID string, pointInTime time.Time) *RestorePlanner {
+	return &RestorePlanner{
+		hpdb:        hpdb,
+		clusterID:   clusterID,
+		pointInTime: pointInTime,
+	}
+}
+
+// SetSourceType : Allow user to set the type of the backup source: default or cos
+func (planner *RestorePlanner) SetSourceType(sourceType string) *RestorePlanner {
+	planner.options.SetSourceType(sourceType)
+	return planner
+}
+
+// SetCosHmacKeys : Allow user to set CosHmacKeys
+func (planner *RestorePlanner) SetCosHmacKeys(cosHmacKeys *CosHmacKeys) *RestorePlanner {
+	planner.options.SetCosHmacKeys(cosHmacKeys)
+	return planner
+}
+
+// SetCosEndpoint : Allow user to set CosEndpoint
+func (planner *RestorePlanner) SetCosEndpoint(cosEndpoint string) *RestorePlanner {
+	planner.options.SetCosEndpoint(cosEndpoint)
+	return planner
+}
+
+// SetBucketInstanceCrn : Allow user to set BucketInstanceCrn
+func (planner *RestorePlanner) SetBucketInstanceCrn(bucketInstanceCrn string) *RestorePlanner {
+	planner.options.SetBucketInstanceCrn(bucketInstanceCrn)
+	return planner
+}
+
+// SetBackupFiles : Allow user to set the backup files in the COS bucket, such as listed by cosbackup.Client.List
+func (planner *RestorePlanner) SetBackupFiles(backupFiles BackupFiles) *RestorePlanner {
+	planner.backupFiles = backupFiles
+	return planner
+}
+
+// SetHeaders : Allow user to set the headers of the API requests
+func (planner *RestorePlanner) SetHeaders(headers map[string]string) *RestorePlanner {
+	planner.options.SetHeaders(headers)
+	return planner
+}
+
+// RestorePlan : The plan to restore a cluster to a point in time, computed by RestorePlanner.Plan.
+type RestorePlan struct {
+	// The ID of the cluster.
+	ClusterID string
+
+	// The point in time to restore the cluster to.
+	PointInTime time.Time
+
+	// The most recent backup created at or before the point in time. For the cos source, this is the backup listed by
+	// ListBackups which matches the backup file, and it is empty if there is none.
+	Backup Backup
+
+	// The time when the backup, or the backup file for the cos source, was created.
+	BackupTime time.Time
+
+	// The options of the Restore request which Apply makes.
+	Options *RestoreOptions
+
+	planner *RestorePlanner
+}
+
+// Plan selects the most recent backup which was created at or before the point in time, and builds the options to
+// restore it. For the default source, the backup is selected among the backups listed by ListBackups and restored by
+// its ID. For the cos source, it is selected among the backup files set by SetBackupFiles, so that only a file which
+// exists in the bucket is restored. It fails if there is no such backup, if the COS parameters or backup files are
+// missing for the cos source, or if a task is running on the cluster.
+func (planner *RestorePlanner) Plan(ctx context.Context) (plan *RestorePlan, err error) {
+	if planner.clusterID == "" {
+		return nil, fmt.Errorf("clusterID cannot be empty")
+	}
+	sourceType := planner.options.GetSourceType()
+	switch sourceType {
+	case "", RestoreSourceTypeDefault:
+		sourceType = RestoreSourceTypeDefault
+	case RestoreSourceTypeCos:
+		if planner.options.CosEndpoint == nil || planner.options.BucketInstanceCrn == nil {
+			return nil, fmt.Errorf("the COS endpoint and bucket instance CRN are required to restore from COS")
+		}
+		if planner.backupFiles == nil {
+			return nil, fmt.Errorf("the backup files in the bucket are required to restore from COS: set them with SetBackupFiles")
+		}
+	default:
+		return nil, fmt.Errorf("invalid source type %q: use %s or %s", sourceType, RestoreSourceTypeDefault, RestoreSourceTypeCos)
+	}
+
+	listBackupsOptions := planner.hpdb.NewListBackupsOptions(planner.clusterID)
+	listBackupsOptions.SetHeaders(planner.options.Headers)
+	backups, _, err := planner.hpdb.ListBackupsWithContext(ctx, listBackupsOptions)
+	if err != nil {
+		return
+	}
+	plan = &RestorePlan{ClusterID: planner.clusterID, PointInTime: planner.pointInTime.UTC(), planner: planner}
+	var backupFile BackupFile
+	if sourceType == RestoreSourceTypeDefault {
+		found := false
+		for _, backup := range backups.Backups {
+			createdAt, err := backup.GetCreatedAt()
+			if err != nil || createdAt.IsZero() || backup.ID == nil || createdAt.After(planner.pointInTime) || createdAt.Before(plan.BackupTime) {
+				continue
+			}
+			plan.Backup, plan.BackupTime, found = backup, createdAt, true
+		}
+		if !found {
+			return nil, fmt.Errorf("cluster %s has no backup created at or before %s", planner.clusterID, plan.PointInTime.Format(time.RFC3339))
+		}
+	} else {
+		var found bool
+		backupFile, found = planner.backupFiles.Latest(planner.pointInTime)
+		if !found {
+			return nil, fmt.Errorf("bucket %s has no backup file created at or before %s", *planner.options.BucketInstanceCrn, plan.PointInTime.Format(time.RFC3339))
+		}
+		plan.BackupTime = backupFile.CreatedAt
+		for _, backup := range backups.Backups {
+			if backupFile.Matches(&backup) {
+				plan.Backup = backup
+			}
+		}
+	}
+	if err = planner.hpdb.checkNoRunningTask(ctx, planner.clusterID, planner.options.Headers); err != nil {
+		return nil, err
+	}
+
+	options := planner.options
+	options.SetClusterID(planner.clusterID)
+	options.SetSourceType(string(sourceType))
+	if sourceType == RestoreSourceTypeDefault {
+		options.BackupID = core.StringPtr(*plan.Backup.ID)
+	} else {
+		options.BackupFile = core.StringPtr(backupFile.Name)
+	}
+	plan.Options = &options
+	return plan, nil
+}
+
+// String returns a human-readable summary of the plan, to be confirmed before it is applied.
+func (plan *RestorePlan) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Restore plan for cluster %s:\n", plan.ClusterID)
+	fmt.Fprintf(&b, "  Point in time: %s\n", plan.PointInTime.Format(time.RFC3339))
+	backup := core.StringNilMapper(plan.Backup.ID)
+	if plan.Backup.ID == nil && plan.Options != nil && plan.Options.BackupFile != nil {
+		backup = "backup file " + *plan.Options.BackupFile
+	}
+	if plan.Backup.Type != nil {
+		backup += " (" + *plan.Backup.Type + ")"
+	}
+	fmt.Fprintf(&b, "  Backup:        %s created at %s, %s before\n", strings.TrimSpace(backup),
+		plan.BackupTime.Format(time.RFC3339), plan.PointInTime.Sub(plan.BackupTime))
+	if plan.Options != nil {
+		fmt.Fprintf(&b, "  Source:        %s", plan.Options.GetSourceType())
+		if plan.Options.BackupFile != nil {
+			fmt.Fprintf(&b, ", backup file %s in bucket %s", *plan.Options.BackupFile, core.StringNilMapper(plan.Options.BucketInstanceCrn))
+		}
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(&b, "  Warning: the data written to the cluster after %s will be lost.\n", plan.BackupTime.Format(time.RFC3339))
+	return b.String()
+}
+
+// Apply restores the backup of the plan with Restore, and waits for its task to finish. It fails without making any
+// change if a task is running on the cluster.
+func (plan *RestorePlan) Apply(ctx context.Context, waitForTaskOptions *WaitForTaskOptions) (operation *Operation, err error) {
+	planner := plan.planner
+	if planner == nil || plan.Options == nil {
+		return nil, fmt.Errorf("the plan was not made by RestorePlanner.Plan")
+	}
+	if err = planner.hpdb.checkNoRunningTask(ctx, plan.ClusterID, plan.Options.Headers); err != nil {
+		return
+	}
+	operation, _, err = planner.hpdb.RestoreAndWait(ctx, plan.Options, waitForTaskOptions)
+	return
+}
+
+// RestoreToTime : Restore a cluster to a point in time
+// Restore a cluster that is indicated by its ID from the most recent of its default backups which was created at or
+// before the specified time, and wait for the resulting task to finish. Use NewRestorePlanner to restore from COS, or to
+// review the plan before it is applied.
+func (hpdb *HpdbV3) RestoreToTime(ctx context.Context, clusterID string, pointInTime time.Time) (operation *Operation, err error) {
+	plan, err := hpdb.NewRestorePlanner(clusterID, pointInTime).Plan(ctx)
+	if err != nil {
+		return
+	}
+	return plan.Apply(ctx, nil)
+}
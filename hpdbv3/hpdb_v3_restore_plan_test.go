@@ -0,0 +1,169 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3/hpdbv3test"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`HpdbV3 restore planner`, func() {
+	var server *hpdbv3test.Server
+	var hpdbService *hpdbv3.HpdbV3
+	clusterID := "9cebab98-afeb-4886-9a29-8e741716e7ff"
+	bucketInstanceCrn := "crn:v1:bluemix:public:cloud-object-storage:global:a/23a24a3e3fe7a115473f07be1c44bdb5:bucket:backups"
+	fastWait := &hpdbv3.WaitForTaskOptions{Interval: time.Millisecond}
+
+	BeforeEach(func() {
+		server = hpdbv3test.NewServer()
+		server.SetTaskDuration(0)
+		server.AddCluster(hpdbv3test.NewCluster(clusterID))
+		server.AddBackup(clusterID, hpdbv3.Backup{ID: core.StringPtr("backup-1"), Type: core.StringPtr("scheduled"), CreatedAt: core.StringPtr("2026-03-01T08:00:00.000Z")})
+		server.AddBackup(clusterID, hpdbv3.Backup{ID: core.StringPtr("backup-3"), Type: core.StringPtr("scheduled"), CreatedAt: core.StringPtr("2026-03-02T08:00:00.000Z")})
+		server.AddBackup(clusterID, hpdbv3.Backup{ID: core.StringPtr("backup-2"), Type: core.StringPtr("manual"), CreatedAt: core.StringPtr("2026-03-01T16:30:15.000Z")})
+		var err error
+		hpdbService, err = server.NewClient()
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	pointInTime := time.Date(2026, time.March, 1, 20, 0, 0, 0, time.UTC)
+
+	Describe(`Plan(ctx context.Context)`, func() {
+		It(`Invoke Plan successfully`, func() {
+			plan, err := hpdbService.NewRestorePlanner(clusterID, pointInTime).Plan(context.Background())
+			Expect(err).To(BeNil())
+			Expect(*plan.Backup.ID).To(Equal("backup-2"))
+			Expect(plan.BackupTime).To(Equal(time.Date(2026, time.March, 1, 16, 30, 15, 0, time.UTC)))
+			Expect(plan.Options.GetSourceType()).To(Equal(hpdbv3.RestoreSourceTypeDefault))
+			Expect(*plan.Options.ClusterID).To(Equal(clusterID))
+			Expect(*plan.Options.BackupID).To(Equal("backup-2"))
+			Expect(plan.Options.BackupFile).To(BeNil())
+			Expect(plan.String()).To(Equal("Restore plan for cluster " + clusterID + ":\n" +
+				"  Point in time: 2026-03-01T20:00:00Z\n" +
+				"  Backup:        backup-2 (manual) created at 2026-03-01T16:30:15Z, 3h29m45s before\n" +
+				"  Source:        default\n" +
+				"  Warning: the data written to the cluster after 2026-03-01T16:30:15Z will be lost.\n"))
+
+			// A backup created at the point in time is selected
+			plan, err = hpdbService.NewRestorePlanner(clusterID, time.Date(2026, time.March, 2, 8, 0, 0, 0, time.UTC)).Plan(context.Background())
+			Expect(err).To(BeNil())
+			Expect(*plan.Backup.ID).To(Equal("backup-3"))
+			Expect(server.Tasks(clusterID)).To(BeEmpty())
+		})
+		var newCosPlanner = func(pointInTime time.Time, names ...string) *hpdbv3.RestorePlanner {
+			backupFiles, err := hpdbv3.ParseBackupFiles(names)
+			Expect(err).To(BeNil())
+			return hpdbService.NewRestorePlanner(clusterID, pointInTime).
+				SetSourceType("cos").
+				SetCosHmacKeys(&hpdbv3.CosHmacKeys{AccessKeyID: core.StringPtr("access-key-id"), SecretAccessKey: core.StringPtr("secret-access-key")}).
+				SetCosEndpoint("s3.us-south.cloud-object-storage.appdomain.cloud").
+				SetBucketInstanceCrn(bucketInstanceCrn).
+				SetBackupFiles(backupFiles)
+		}
+		It(`Invoke Plan successfully from COS`, func() {
+			plan, err := newCosPlanner(pointInTime, "2026-03-01-080000Z", "2026-03-01-163015Z", "2026-03-02-080000Z").Plan(context.Background())
+			Expect(err).To(BeNil())
+			Expect(plan.Options.GetSourceType()).To(Equal(hpdbv3.RestoreSourceTypeCos))
+			Expect(*plan.Options.BackupFile).To(Equal("2026-03-01-163015Z"))
+			Expect(plan.Options.BackupID).To(BeNil())
+			Expect(*plan.Options.CosHmacKeys.AccessKeyID).To(Equal("access-key-id"))
+			Expect(*plan.Backup.ID).To(Equal("backup-2"))
+			Expect(plan.String()).To(ContainSubstring("  Source:        cos, backup file 2026-03-01-163015Z in bucket " + bucketInstanceCrn + "\n"))
+		})
+		It(`Invoke Plan successfully from COS with a backup file not listed by ListBackups`, func() {
+			// The backup file is written a second after the creation time of backup-2, and it is the one restored
+			plan, err := newCosPlanner(pointInTime, "2026-03-01-080000Z", "2026-03-01-163016Z").Plan(context.Background())
+			Expect(err).To(BeNil())
+			Expect(*plan.Options.BackupFile).To(Equal("2026-03-01-163016Z"))
+			Expect(plan.Backup.ID).To(BeNil())
+			Expect(plan.BackupTime).To(Equal(time.Date(2026, time.March, 1, 16, 30, 16, 0, time.UTC)))
+			Expect(plan.String()).To(ContainSubstring("  Backup:        backup file 2026-03-01-163016Z created at 2026-03-01T16:30:16Z, 3h29m44s before\n"))
+		})
+		It(`Invoke Plan with error`, func() {
+			_, err := hpdbService.NewRestorePlanner(clusterID, time.Date(2026, time.March, 1, 7, 59, 59, 0, time.UTC)).Plan(context.Background())
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(Equal("cluster " + clusterID + " has no backup created at or before 2026-03-01T07:59:59Z"))
+
+			_, err = hpdbService.NewRestorePlanner(clusterID, pointInTime).SetSourceType("cos").Plan(context.Background())
+			Expect(err).ToNot(BeNil())
+			_, err = newCosPlanner(pointInTime).SetBackupFiles(nil).Plan(context.Background())
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("SetBackupFiles"))
+			_, err = newCosPlanner(pointInTime, "2026-03-02-080000Z").Plan(context.Background())
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(Equal("bucket " + bucketInstanceCrn + " has no backup file created at or before 2026-03-01T20:00:00Z"))
+			_, err = hpdbService.NewRestorePlanner(clusterID, pointInTime).SetSourceType("tape").Plan(context.Background())
+			Expect(err).ToNot(BeNil())
+			_, err = hpdbService.NewRestorePlanner("", pointInTime).Plan(context.Background())
+			Expect(err).ToNot(BeNil())
+			_, err = hpdbService.NewRestorePlanner("no-such-cluster", pointInTime).Plan(context.Background())
+			Expect(err).ToNot(BeNil())
+		})
+	})
+	Describe(`Apply(ctx context.Context, waitForTaskOptions *WaitForTaskOptions)`, func() {
+		It(`Invoke Apply successfully`, func() {
+			plan, err := hpdbService.NewRestorePlanner(clusterID, pointInTime).Plan(context.Background())
+			Expect(err).To(BeNil())
+			operation, err := plan.Apply(context.Background(), fastWait)
+			Expect(err).To(BeNil())
+			Expect(operation.ClusterID()).To(Equal(clusterID))
+			task, err := operation.Result()
+			Expect(err).To(BeNil())
+			Expect(*task.State).To(Equal("SUCCEEDED"))
+
+			tasks := server.Tasks(clusterID)
+			Expect(tasks).To(HaveLen(1))
+			Expect(*tasks[0].Type).To(Equal("restore"))
+			Expect(tasks[0].Spec["backup_id"]).To(Equal(core.StringPtr("backup-2")))
+		})
+		It(`Invoke Apply with error: Failed task`, func() {
+			plan, err := hpdbService.NewRestorePlanner(clusterID, pointInTime).Plan(context.Background())
+			Expect(err).To(BeNil())
+			server.FailNextTask("restore failed")
+
+			operation, err := plan.Apply(context.Background(), fastWait)
+			var taskFailedErr *hpdbv3.TaskFailedError
+			Expect(errors.As(err, &taskFailedErr)).To(BeTrue())
+			Expect(operation).ToNot(BeNil())
+		})
+		It(`Invoke Apply with error: Plan not made by Plan`, func() {
+			_, err := (&hpdbv3.RestorePlan{ClusterID: clusterID}).Apply(context.Background(), nil)
+			Expect(err).ToNot(BeNil())
+			Expect(server.Requests()).To(BeEmpty())
+		})
+	})
+	Describe(`RestoreToTime(ctx context.Context, clusterID string, pointInTime time.Time)`, func() {
+		It(`Invoke RestoreToTime successfully`, func() {
+			operation, err := hpdbService.RestoreToTime(context.Background(), clusterID, time.Date(2026, time.March, 3, 0, 0, 0, 0, time.UTC))
+			Expect(err).To(BeNil())
+			task, err := operation.Result()
+			Expect(err).To(BeNil())
+			Expect(*task.Type).To(Equal("restore"))
+			Expect(server.Tasks(clusterID)[0].Spec["backup_id"]).To(Equal(core.StringPtr("backup-3")))
+		})
+	})
+})
@@ -0,0 +1,329 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ScalePlanner : Computes the plan to scale the resources of a cluster to a target, and applies it.
+// Plan reads the cluster and its tasks without changing anything, so that the plan can be reviewed or approved before
+// it is applied with ScalePlan.Apply.
+type ScalePlanner struct {
+	hpdb      *HpdbV3
+	clusterID string
+	target    *Resources
+	headers   map[string]string
+}
+
+// NewScalePlanner returns a ScalePlanner which scales the resources of the cluster to the target. The resources which
+// are not set in the target are not changed.
+func (hpdb *HpdbV3) NewScalePlanner(clusterID string, target *Resources) *ScalePlanner {
+	return &ScalePlanner{
+		hpdb:      hpdb,
+		clusterID: clusterID,
+		target:    target,
+	}
+}
+
+// SetHeaders : Allow user to set the headers of the API requests
+func (planner *ScalePlanner) SetHeaders(headers map[string]string) *ScalePlanner {
+	planner.headers = headers
+	return planner
+}
+
+// ResourceChange : The change of one resource of a cluster.
+type ResourceChange struct {
+	// The resource: cpu, memory or storage.
+	Resource string
+
+	// The current value.
+	From string
+
+	// The target value.
+	To string
+}
+
+// ScaleStep : A ScaleResources request of a ScalePlan.
+type ScaleStep struct {
+	// The resources requested by the step.
+	Resource *Resources
+
+	// What the step does, such as "scale memory from 4GiB to 64GiB".
+	Description string
+}
+
+// ScalePlan : The plan to scale the resources of a cluster, computed by ScalePlanner.Plan.
+type ScalePlan struct {
+	// The ID of the cluster.
+	ClusterID string
+
+	// The resources of the cluster when the plan was made.
+	Current *ClusterResource
+
+	// The target resources.
+	Target *Resources
+
+	// The resources which change. It is empty if the cluster already has the target resources.
+	Changes []ResourceChange
+
+	// The ScaleResources requests which Apply makes, in order.
+	Steps []ScaleStep
+
+	planner *ScalePlanner
+}
+
+// ClusterBusyError is returned by the planners, such as ScalePlanner.Plan, and by the Apply methods of their plans when
+// a task is running on the cluster.
+type ClusterBusyError struct {
+	// The ID of the cluster.
+	ClusterID string
+
+	// The IDs of the running tasks.
+	TaskIDs []string
+}
+
+// Error returns the error message.
+func (e *ClusterBusyError) Error() string {
+	return fmt.Sprintf("cluster %s is busy: task %s is running", e.ClusterID, strings.Join(e.TaskIDs, ", "))
+}
+
+// Plan computes the plan to scale the cluster to the target resources. It fails if the target is not valid (when
+// resource validation is enabled with EnableResourceValidation) or if a resource changes while a task is running on the
+// cluster. A plan with no changes is made even if a task is running, since its Apply does nothing.
+//
+// Storage, which can only grow, is scaled first. CPUs and memory are then changed in separate steps. When the memory
+// shrinks, it is reduced before the CPUs change: 8 CPUs with 32GiB are scaled down to 2 CPUs with 4GiB through 8 CPUs
+// with 4GiB. When it grows, CPUs are reduced before it and increased after it.
+func (planner *ScalePlanner) Plan(ctx context.Context) (plan *ScalePlan, err error) {
+	if planner.clusterID == "" {
+		return nil, fmt.Errorf("clusterID cannot be empty")
+	}
+	if planner.target == nil {
+		return nil, fmt.Errorf("target resources cannot be nil")
+	}
+	current, err := planner.currentResources(ctx)
+	if err != nil {
+		return
+	}
+	if planner.hpdb.validateResources {
+		if err = ValidateResources(planner.target, current); err != nil {
+			return
+		}
+	}
+
+	plan = &ScalePlan{
+		ClusterID: planner.clusterID,
+		Current:   current,
+		Target:    planner.target,
+		planner:   planner,
+	}
+	var cpuChange, memoryChange, storageChange *ResourceChange
+	if planner.target.Cpu != nil && (current.Cpu == nil || *current.Cpu != *planner.target.Cpu) {
+		cpuChange = &ResourceChange{Resource: "cpu", From: formatCpu(current.Cpu), To: formatCpu(planner.target.Cpu)}
+	}
+	if memoryChange, err = quantityChange("memory", current.Memory, planner.target.Memory); err != nil {
+		return nil, err
+	}
+	if storageChange, err = quantityChange("storage", current.Storage, planner.target.Storage); err != nil {
+		return nil, err
+	}
+	for _, change := range []*ResourceChange{cpuChange, memoryChange, storageChange} {
+		if change != nil {
+			plan.Changes = append(plan.Changes, *change)
+		}
+	}
+
+	if storageChange != nil {
+		plan.addStep(storageChange, &Resources{Storage: planner.target.Storage})
+	}
+	memoryDecreases := false
+	if memoryChange != nil && current.Memory != nil {
+		// The sizes were parsed by quantityChange
+		from, _ := current.GetMemory()
+		to, _ := planner.target.GetMemory()
+		memoryDecreases = to < from
+	}
+	cpuFirst := cpuChange != nil && !memoryDecreases && current.Cpu != nil && *planner.target.Cpu < *current.Cpu
+	if cpuFirst {
+		plan.addStep(cpuChange, &Resources{Cpu: planner.target.Cpu})
+	}
+	if memoryChange != nil {
+		plan.addStep(memoryChange, &Resources{Memory: planner.target.Memory})
+	}
+	if cpuChange != nil && !cpuFirst {
+		plan.addStep(cpuChange, &Resources{Cpu: planner.target.Cpu})
+	}
+
+	if !plan.IsEmpty() {
+		if err = planner.hpdb.checkNoRunningTask(ctx, planner.clusterID, planner.headers); err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
+// IsEmpty returns true if the cluster already has the target resources, so that Apply has nothing to do.
+func (plan *ScalePlan) IsEmpty() bool {
+	return len(plan.Steps) == 0
+}
+
+// String returns a human-readable description of the plan.
+func (plan *ScalePlan) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Scale plan for cluster %s:\n", plan.ClusterID)
+	if plan.IsEmpty() {
+		b.WriteString("  No changes: the cluster already has the target resources.\n")
+		return b.String()
+	}
+	b.WriteString("  Changes:\n")
+	for _, change := range plan.Changes {
+		fmt.Fprintf(&b, "    %-8s %s -> %s\n", change.Resource+":", change.From, change.To)
+	}
+	b.WriteString("  Steps:\n")
+	for i, step := range plan.Steps {
+		fmt.Fprintf(&b, "    %d. %s\n", i+1, step.Description)
+	}
+	return b.String()
+}
+
+// Apply runs the steps of the plan in order, waiting for the task of each step to finish before the next one starts.
+// It fails without making any change if the resources of the cluster changed since the plan was made, or if a task is
+// running on the cluster. It returns the operations of the steps which were started, including the one which failed.
+func (plan *ScalePlan) Apply(ctx context.Context, waitForTaskOptions *WaitForTaskOptions) (operations []*Operation, err error) {
+	if plan.IsEmpty() {
+		return
+	}
+	planner := plan.planner
+	if planner == nil {
+		return nil, fmt.Errorf("the plan was not made by ScalePlanner.Plan")
+	}
+	current, err := planner.currentResources(ctx)
+	if err != nil {
+		return
+	}
+	if !sameResources(current, plan.Current) {
+		return nil, fmt.Errorf("the resources of cluster %s changed since the plan was made; make a new plan", plan.ClusterID)
+	}
+	if err = planner.hpdb.checkNoRunningTask(ctx, planner.clusterID, planner.headers); err != nil {
+		return
+	}
+
+	for i, step := range plan.Steps {
+		scaleResourcesOptions := planner.hpdb.NewScaleResourcesOptions(plan.ClusterID).SetResource(step.Resource)
+		scaleResourcesOptions.SetHeaders(planner.headers)
+		operation, _, stepErr := planner.hpdb.ScaleResourcesAndWait(ctx, scaleResourcesOptions, waitForTaskOptions)
+		if operation != nil {
+			operations = append(operations, operation)
+		}
+		if stepErr != nil {
+			return operations, fmt.Errorf("step %d of %d (%s) failed: %w", i+1, len(plan.Steps), step.Description, stepErr)
+		}
+	}
+	return
+}
+
+func (plan *ScalePlan) addStep(change *ResourceChange, resource *Resources) {
+	plan.Steps = append(plan.Steps, ScaleStep{
+		Resource:    resource,
+		Description: fmt.Sprintf("scale %s from %s to %s", change.Resource, change.From, change.To),
+	})
+}
+
+// currentResources returns the resources of the cluster.
+func (planner *ScalePlanner) currentResources(ctx context.Context) (*ClusterResource, error) {
+	getClusterOptions := planner.hpdb.NewGetClusterOptions(planner.clusterID)
+	getClusterOptions.SetHeaders(planner.headers)
+	cluster, _, err := planner.hpdb.GetClusterWithContext(ctx, getClusterOptions)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.Resource == nil {
+		return nil, fmt.Errorf("the resources of cluster %s are unknown", planner.clusterID)
+	}
+	return cluster.Resource, nil
+}
+
+// checkNoRunningTask returns a *ClusterBusyError if a task is running on the cluster.
+func (hpdb *HpdbV3) checkNoRunningTask(ctx context.Context, clusterID string, headers map[string]string) error {
+	listTasksOptions := hpdb.NewListTasksOptions(clusterID)
+	listTasksOptions.SetHeaders(headers)
+	tasks, _, err := hpdb.ListTasksWithContext(ctx, listTasksOptions)
+	if err != nil {
+		return err
+	}
+	var running []string
+	for i := range tasks.Tasks {
+		if tasks.Tasks[i].GetState() == TaskStateRunning {
+			running = append(running, core.StringNilMapper(tasks.Tasks[i].ID))
+		}
+	}
+	if len(running) > 0 {
+		return &ClusterBusyError{ClusterID: clusterID, TaskIDs: running}
+	}
+	return nil
+}
+
+// quantityChange returns the change of a size, or nil if the target is not set or has the current size.
+func quantityChange(resource string, current *string, target *string) (*ResourceChange, error) {
+	if target == nil {
+		return nil, nil
+	}
+	to, err := ParseQuantity(*target)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target %s: %s", resource, err.Error())
+	}
+	from, err := parseQuantityField(current)
+	if err != nil {
+		return nil, fmt.Errorf("invalid current %s: %s", resource, err.Error())
+	}
+	if current != nil && from == to {
+		return nil, nil
+	}
+	change := &ResourceChange{Resource: resource, From: "unknown", To: to.String()}
+	if current != nil {
+		change.From = from.String()
+	}
+	return change, nil
+}
+
+// sameResources returns true if two resources have the same CPUs, memory and storage.
+func sameResources(a *ClusterResource, b *ClusterResource) bool {
+	if formatCpu(a.Cpu) != formatCpu(b.Cpu) {
+		return false
+	}
+	for _, sizes := range [][2]*string{{a.Memory, b.Memory}, {a.Storage, b.Storage}} {
+		x, errX := parseQuantityField(sizes[0])
+		y, errY := parseQuantityField(sizes[1])
+		if errX != nil || errY != nil || x != y {
+			return false
+		}
+	}
+	return true
+}
+
+func formatCpu(cpu *int64) string {
+	if cpu == nil {
+		return "unknown"
+	}
+	return strconv.FormatInt(*cpu, 10)
+}
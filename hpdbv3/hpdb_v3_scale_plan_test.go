@@ -0,0 +1,238 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3/hpdbv3test"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`HpdbV3 scale planner`, func() {
+	var server *hpdbv3test.Server
+	var hpdbService *hpdbv3.HpdbV3
+	clusterID := "9cebab98-afeb-4886-9a29-8e741716e7ff"
+	clusterPath := "/clusters/" + clusterID
+	fastWait := &hpdbv3.WaitForTaskOptions{Interval: time.Millisecond}
+
+	BeforeEach(func() {
+		server = hpdbv3test.NewServer()
+		server.SetTaskDuration(0)
+		// The cluster has 2 CPUs, 4GiB of memory and 10GiB of storage
+		server.AddCluster(hpdbv3test.NewCluster(clusterID))
+		var err error
+		hpdbService, err = server.NewClient()
+		Expect(err).To(BeNil())
+		hpdbService.EnableResourceValidation()
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe(`Plan(ctx context.Context)`, func() {
+		It(`Invoke Plan successfully when scaling up`, func() {
+			plan, err := hpdbService.NewScalePlanner(clusterID, &hpdbv3.Resources{
+				Cpu:     core.Int64Ptr(12),
+				Memory:  core.StringPtr("64GiB"),
+				Storage: core.StringPtr("10GiB"),
+			}).Plan(context.Background())
+			Expect(err).To(BeNil())
+			Expect(plan.Changes).To(Equal([]hpdbv3.ResourceChange{
+				{Resource: "cpu", From: "2", To: "12"},
+				{Resource: "memory", From: "4GiB", To: "64GiB"},
+			}))
+			Expect(plan.Steps).To(Equal([]hpdbv3.ScaleStep{
+				{Resource: &hpdbv3.Resources{Memory: core.StringPtr("64GiB")}, Description: "scale memory from 4GiB to 64GiB"},
+				{Resource: &hpdbv3.Resources{Cpu: core.Int64Ptr(12)}, Description: "scale cpu from 2 to 12"},
+			}))
+			Expect(plan.String()).To(Equal("Scale plan for cluster " + clusterID + ":\n" +
+				"  Changes:\n" +
+				"    cpu:     2 -> 12\n" +
+				"    memory:  4GiB -> 64GiB\n" +
+				"  Steps:\n" +
+				"    1. scale memory from 4GiB to 64GiB\n" +
+				"    2. scale cpu from 2 to 12\n"))
+
+			// Planning does not change anything
+			Expect(server.Requests()).To(Equal([]string{"GET " + clusterPath, "GET " + clusterPath + "/tasks"}))
+		})
+		It(`Invoke Plan successfully when scaling down`, func() {
+			plan, err := hpdbService.NewScalePlanner(clusterID, &hpdbv3.Resources{
+				Cpu:     core.Int64Ptr(1),
+				Memory:  core.StringPtr("2GiB"),
+				Storage: core.StringPtr("16GiB"),
+			}).Plan(context.Background())
+			Expect(err).To(BeNil())
+			var steps []string
+			for _, step := range plan.Steps {
+				steps = append(steps, step.Description)
+			}
+			Expect(steps).To(Equal([]string{
+				"scale storage from 10GiB to 16GiB",
+				"scale memory from 4GiB to 2GiB",
+				"scale cpu from 2 to 1",
+			}))
+		})
+		It(`Invoke Plan successfully when scaling CPUs and memory down together`, func() {
+			server.UpdateCluster(clusterID, func(cluster *hpdbv3.Cluster) {
+				cluster.Resource.Cpu = core.Int64Ptr(8)
+				cluster.Resource.Memory = core.StringPtr("32GiB")
+			})
+			plan, err := hpdbService.NewScalePlanner(clusterID, &hpdbv3.Resources{
+				Cpu:    core.Int64Ptr(2),
+				Memory: core.StringPtr("4GiB"),
+			}).Plan(context.Background())
+			Expect(err).To(BeNil())
+
+			// The memory is reduced first, so that the intermediate step does not keep 32GiB for 2 CPUs
+			Expect(plan.Steps).To(Equal([]hpdbv3.ScaleStep{
+				{Resource: &hpdbv3.Resources{Memory: core.StringPtr("4GiB")}, Description: "scale memory from 32GiB to 4GiB"},
+				{Resource: &hpdbv3.Resources{Cpu: core.Int64Ptr(2)}, Description: "scale cpu from 8 to 2"},
+			}))
+		})
+		It(`Invoke Plan successfully when scaling CPUs up and memory down`, func() {
+			plan, err := hpdbService.NewScalePlanner(clusterID, &hpdbv3.Resources{
+				Cpu:    core.Int64Ptr(4),
+				Memory: core.StringPtr("2GiB"),
+			}).Plan(context.Background())
+			Expect(err).To(BeNil())
+
+			// The intermediate step has 1GiB per CPU, between the current 2GiB and the target 512MiB
+			Expect(plan.Steps).To(Equal([]hpdbv3.ScaleStep{
+				{Resource: &hpdbv3.Resources{Memory: core.StringPtr("2GiB")}, Description: "scale memory from 4GiB to 2GiB"},
+				{Resource: &hpdbv3.Resources{Cpu: core.Int64Ptr(4)}, Description: "scale cpu from 2 to 4"},
+			}))
+		})
+		It(`Invoke Plan successfully when scaling CPUs down and memory up`, func() {
+			server.UpdateCluster(clusterID, func(cluster *hpdbv3.Cluster) {
+				cluster.Resource.Cpu = core.Int64Ptr(4)
+			})
+			plan, err := hpdbService.NewScalePlanner(clusterID, &hpdbv3.Resources{
+				Cpu:    core.Int64Ptr(2),
+				Memory: core.StringPtr("8GiB"),
+			}).Plan(context.Background())
+			Expect(err).To(BeNil())
+			Expect(plan.Steps).To(Equal([]hpdbv3.ScaleStep{
+				{Resource: &hpdbv3.Resources{Cpu: core.Int64Ptr(2)}, Description: "scale cpu from 4 to 2"},
+				{Resource: &hpdbv3.Resources{Memory: core.StringPtr("8GiB")}, Description: "scale memory from 4GiB to 8GiB"},
+			}))
+		})
+		It(`Invoke Plan successfully with no changes`, func() {
+			plan, err := hpdbService.NewScalePlanner(clusterID, &hpdbv3.Resources{Cpu: core.Int64Ptr(2), Memory: core.StringPtr("4096MiB")}).Plan(context.Background())
+			Expect(err).To(BeNil())
+			Expect(plan.IsEmpty()).To(BeTrue())
+			Expect(plan.Changes).To(BeEmpty())
+			Expect(plan.String()).To(ContainSubstring("No changes"))
+		})
+		It(`Invoke Plan with error: Invalid target`, func() {
+			_, err := hpdbService.NewScalePlanner(clusterID, &hpdbv3.Resources{Storage: core.StringPtr("5GiB")}).Plan(context.Background())
+			Expect(err).To(BeAssignableToTypeOf(&hpdbv3.ResourceValidationError{}))
+
+			_, err = hpdbService.NewScalePlanner(clusterID, nil).Plan(context.Background())
+			Expect(err).ToNot(BeNil())
+			_, err = hpdbService.NewScalePlanner("", &hpdbv3.Resources{}).Plan(context.Background())
+			Expect(err).ToNot(BeNil())
+			_, err = hpdbService.NewScalePlanner("unknown", &hpdbv3.Resources{}).Plan(context.Background())
+			Expect(err).ToNot(BeNil())
+		})
+		It(`Invoke Plan with error: Running task`, func() {
+			server.SetTaskDuration(time.Minute)
+			taskID, _, err := hpdbService.ScaleResources(hpdbService.NewScaleResourcesOptions(clusterID).SetResource(&hpdbv3.Resources{Cpu: core.Int64Ptr(4)}))
+			Expect(err).To(BeNil())
+
+			_, err = hpdbService.NewScalePlanner(clusterID, &hpdbv3.Resources{Cpu: core.Int64Ptr(8)}).Plan(context.Background())
+			var busyErr *hpdbv3.ClusterBusyError
+			Expect(errors.As(err, &busyErr)).To(BeTrue())
+			Expect(busyErr.TaskIDs).To(Equal([]string{*taskID.TaskID}))
+			Expect(err.Error()).To(Equal("cluster " + clusterID + " is busy: task " + *taskID.TaskID + " is running"))
+
+			// A plan with no changes does not check the tasks
+			plan, err := hpdbService.NewScalePlanner(clusterID, &hpdbv3.Resources{Cpu: core.Int64Ptr(2)}).Plan(context.Background())
+			Expect(err).To(BeNil())
+			Expect(plan.IsEmpty()).To(BeTrue())
+		})
+	})
+	Describe(`Apply(ctx context.Context, waitForTaskOptions *WaitForTaskOptions)`, func() {
+		It(`Invoke Apply successfully`, func() {
+			plan, err := hpdbService.NewScalePlanner(clusterID, &hpdbv3.Resources{
+				Cpu:     core.Int64Ptr(12),
+				Memory:  core.StringPtr("64GiB"),
+				Storage: core.StringPtr("24GiB"),
+			}).Plan(context.Background())
+			Expect(err).To(BeNil())
+
+			operations, err := plan.Apply(context.Background(), fastWait)
+			Expect(err).To(BeNil())
+			Expect(operations).To(HaveLen(3))
+			cluster, _ := server.Cluster(clusterID)
+			Expect(*cluster.Resource.Cpu).To(Equal(int64(12)))
+			Expect(*cluster.Resource.Memory).To(Equal("64GiB"))
+			Expect(*cluster.Resource.Storage).To(Equal("24GiB"))
+			Expect(server.Tasks(clusterID)).To(HaveLen(3))
+		})
+		It(`Invoke Apply successfully with no changes`, func() {
+			plan, err := hpdbService.NewScalePlanner(clusterID, &hpdbv3.Resources{Cpu: core.Int64Ptr(2)}).Plan(context.Background())
+			Expect(err).To(BeNil())
+			requests := len(server.Requests())
+
+			operations, err := plan.Apply(context.Background(), fastWait)
+			Expect(err).To(BeNil())
+			Expect(operations).To(BeEmpty())
+			Expect(server.Requests()).To(HaveLen(requests))
+		})
+		It(`Invoke Apply with error: Stale plan`, func() {
+			plan, err := hpdbService.NewScalePlanner(clusterID, &hpdbv3.Resources{Cpu: core.Int64Ptr(4)}).Plan(context.Background())
+			Expect(err).To(BeNil())
+			server.UpdateCluster(clusterID, func(cluster *hpdbv3.Cluster) {
+				cluster.Resource.Memory = core.StringPtr("8GiB")
+			})
+
+			operations, err := plan.Apply(context.Background(), fastWait)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("changed since the plan was made"))
+			Expect(operations).To(BeEmpty())
+			Expect(server.Tasks(clusterID)).To(BeEmpty())
+		})
+		It(`Invoke Apply with error: Failed step`, func() {
+			plan, err := hpdbService.NewScalePlanner(clusterID, &hpdbv3.Resources{Cpu: core.Int64Ptr(4), Memory: core.StringPtr("8GiB")}).Plan(context.Background())
+			Expect(err).To(BeNil())
+			server.FailNextTask("out of capacity")
+
+			operations, err := plan.Apply(context.Background(), fastWait)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(HavePrefix("step 1 of 2 (scale memory from 4GiB to 8GiB) failed"))
+			var taskFailedErr *hpdbv3.TaskFailedError
+			Expect(errors.As(err, &taskFailedErr)).To(BeTrue())
+			Expect(operations).To(HaveLen(1))
+
+			// The second step did not run
+			cluster, _ := server.Cluster(clusterID)
+			Expect(*cluster.Resource.Cpu).To(Equal(int64(2)))
+		})
+		It(`Invoke Apply with error: Plan not made by Plan`, func() {
+			plan := &hpdbv3.ScalePlan{ClusterID: clusterID, Steps: []hpdbv3.ScaleStep{{Resource: &hpdbv3.Resources{Cpu: core.Int64Ptr(4)}}}}
+			_, err := plan.Apply(context.Background(), fastWait)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
@@ -0,0 +1,168 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DefaultTaskPollInterval is the interval used by WaitForTask between the first two polls of a task.
+const DefaultTaskPollInterval = 10 * time.Second
+
+// DefaultTaskMaxPollInterval is the upper bound applied to the poll interval when backoff is enabled.
+const DefaultTaskMaxPollInterval = 60 * time.Second
+
+// WaitForTaskOptions : Options controlling how WaitForTask polls a task.
+type WaitForTaskOptions struct {
+	// The interval between the first two polls. DefaultTaskPollInterval is used if this is zero.
+	Interval time.Duration
+
+	// The upper bound of the poll interval. DefaultTaskMaxPollInterval is used if this is zero.
+	MaxInterval time.Duration
+
+	// The factor applied to the poll interval after each poll. Values less than or equal to 1 poll at a fixed interval.
+	Multiplier float64
+
+	// Allows users to set headers on the GetTask requests
+	Headers map[string]string
+}
+
+// NewWaitForTaskOptions : Instantiate WaitForTaskOptions
+func (*HpdbV3) NewWaitForTaskOptions() *WaitForTaskOptions {
+	return &WaitForTaskOptions{}
+}
+
+// SetInterval : Allow user to set Interval
+func (_options *WaitForTaskOptions) SetInterval(interval time.Duration) *WaitForTaskOptions {
+	_options.Interval = interval
+	return _options
+}
+
+// SetMaxInterval : Allow user to set MaxInterval
+func (_options *WaitForTaskOptions) SetMaxInterval(maxInterval time.Duration) *WaitForTaskOptions {
+	_options.MaxInterval = maxInterval
+	return _options
+}
+
+// SetMultiplier : Allow user to set Multiplier
+func (_options *WaitForTaskOptions) SetMultiplier(multiplier float64) *WaitForTaskOptions {
+	_options.Multiplier = multiplier
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *WaitForTaskOptions) SetHeaders(param map[string]string) *WaitForTaskOptions {
+	options.Headers = param
+	return options
+}
+
+// nextInterval returns the interval to sleep after a poll that was preceded by the specified interval.
+func (options *WaitForTaskOptions) nextInterval(interval time.Duration) time.Duration {
+	maxInterval := DefaultTaskMaxPollInterval
+	if options != nil && options.MaxInterval > 0 {
+		maxInterval = options.MaxInterval
+	}
+	if interval == 0 {
+		interval = DefaultTaskPollInterval
+		if options != nil && options.Interval > 0 {
+			interval = options.Interval
+		}
+	} else if options != nil && options.Multiplier > 1 {
+		interval = time.Duration(float64(interval) * options.Multiplier)
+	}
+	if interval > maxInterval {
+		interval = maxInterval
+	}
+	return interval
+}
+
+// TaskNodeFailure : The failure of a task on a single node.
+type TaskNodeFailure struct {
+	// The node ID.
+	NodeID string
+
+	// The reason why the task failed on the node.
+	Reason string
+}
+
+// TaskFailedError is returned by WaitForTask when the task ends in the FAILED state.
+type TaskFailedError struct {
+	// The ID of the cluster that the task ran on.
+	ClusterID string
+
+	// The ID of the failed task.
+	TaskID string
+
+	// The reason why the task entered the failed state.
+	Reason string
+
+	// The nodes on which the task failed.
+	Nodes []TaskNodeFailure
+
+	// The final state of the task.
+	Task *Task
+}
+
+// Error returns a message describing the failed task and the reason reported for each failing node.
+func (e *TaskFailedError) Error() string {
+	msg := fmt.Sprintf("task %s on cluster %s failed", e.TaskID, e.ClusterID)
+	if e.Reason != "" {
+		msg += ": " + e.Reason
+	}
+	for _, node := range e.Nodes {
+		msg += fmt.Sprintf("; node %s", node.NodeID)
+		if node.Reason != "" {
+			msg += ": " + node.Reason
+		}
+	}
+	return msg
+}
+
+// newTaskFailedError builds a TaskFailedError from a task in the FAILED state.
+func newTaskFailedError(clusterID string, taskID string, task *Task) *TaskFailedError {
+	e := &TaskFailedError{
+		ClusterID: clusterID,
+		TaskID:    taskID,
+		Task:      task,
+	}
+	if task.Reason != nil {
+		e.Reason = *task.Reason
+	}
+	for _, node := range task.Nodes {
+		if node.GetState() != TaskStateFailed {
+			continue
+		}
+		failure := TaskNodeFailure{}
+		if node.ID != nil {
+			failure.NodeID = *node.ID
+		}
+		if node.Reason != nil {
+			failure.Reason = *node.Reason
+		}
+		e.Nodes = append(e.Nodes, failure)
+	}
+	return e
+}
+
+// WaitForTask : Wait for a task to finish
+// Poll the task that is indicated by its ID until it reaches the SUCCEEDED or FAILED state, or until the context is
+// done. The final task is returned; if it ended in the FAILED state a *TaskFailedError is returned as well.
+func (hpdb *HpdbV3) WaitForTask(ctx context.Context, clusterID string, taskID string, waitForTaskOptions *WaitForTaskOptions) (result *Task, err error) {
+	return hpdb.NewOperation(clusterID, taskID).SetWaitOptions(waitForTaskOptions).Await(ctx)
+}
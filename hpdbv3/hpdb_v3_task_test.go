@@ -0,0 +1,164 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`HpdbV3 task waiter`, func() {
+	var testServer *httptest.Server
+	getTaskPath := "/clusters/9cebab98-afeb-4886-9a29-8e741716e7ff/tasks/c1a15760-a4f2-11ec-b00a-7f684d1dd53"
+	clusterID := "9cebab98-afeb-4886-9a29-8e741716e7ff"
+	taskID := "c1a15760-a4f2-11ec-b00a-7f684d1dd53"
+
+	var newService = func() *hpdbv3.HpdbV3 {
+		hpdbService, serviceErr := hpdbv3.NewHpdbV3(&hpdbv3.HpdbV3Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		Expect(hpdbService).ToNot(BeNil())
+		return hpdbService
+	}
+
+	Describe(`WaitForTask(ctx, clusterID, taskID, waitForTaskOptions)`, func() {
+		Context(`Using mock server endpoint with a task that succeeds`, func() {
+			var requests int
+			BeforeEach(func() {
+				requests = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(getTaskPath))
+					Expect(req.Method).To(Equal("GET"))
+					Expect(req.Header["X-Custom-Header"]).ToNot(BeNil())
+
+					requests++
+					state := "RUNNING"
+					if requests == 3 {
+						state = "SUCCEEDED"
+					}
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					fmt.Fprintf(res, `{"id": "%s", "type": "scale_resources", "state": "%s"}`, taskID, state)
+				}))
+			})
+			It(`Invoke WaitForTask successfully`, func() {
+				hpdbService := newService()
+
+				waitForTaskOptionsModel := hpdbService.NewWaitForTaskOptions()
+				waitForTaskOptionsModel.SetInterval(time.Millisecond)
+				waitForTaskOptionsModel.SetMaxInterval(5 * time.Millisecond)
+				waitForTaskOptionsModel.SetMultiplier(2)
+				waitForTaskOptionsModel.SetHeaders(map[string]string{"x-custom-header": "x-custom-value"})
+				Expect(waitForTaskOptionsModel.Interval).To(Equal(time.Millisecond))
+				Expect(waitForTaskOptionsModel.MaxInterval).To(Equal(5 * time.Millisecond))
+				Expect(waitForTaskOptionsModel.Multiplier).To(Equal(float64(2)))
+
+				result, err := hpdbService.WaitForTask(context.Background(), clusterID, taskID, waitForTaskOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(result).ToNot(BeNil())
+				Expect(*result.State).To(Equal("SUCCEEDED"))
+				Expect(requests).To(Equal(3))
+			})
+			It(`Invoke WaitForTask with error: Context deadline exceeded`, func() {
+				hpdbService := newService()
+
+				ctx, cancelFunc := context.WithTimeout(context.Background(), 10*time.Millisecond)
+				defer cancelFunc()
+				waitForTaskOptionsModel := hpdbService.NewWaitForTaskOptions().SetInterval(time.Second)
+				waitForTaskOptionsModel.SetHeaders(map[string]string{"x-custom-header": "x-custom-value"})
+
+				result, err := hpdbService.WaitForTask(ctx, clusterID, taskID, waitForTaskOptionsModel)
+				Expect(err).To(Equal(context.DeadlineExceeded))
+				Expect(result).ToNot(BeNil())
+				Expect(*result.State).To(Equal("RUNNING"))
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+		Context(`Using mock server endpoint with a task that fails`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(getTaskPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					fmt.Fprintf(res, "%s", `{"id": "c1a15760-a4f2-11ec-b00a-7f684d1dd53", "state": "FAILED", "reason": "scale failed", "nodes": [{"id": "node-1", "state": "SUCCEEDED"}, {"id": "node-2", "state": "FAILED", "reason": "disk full"}]}`)
+				}))
+			})
+			It(`Invoke WaitForTask with error: Task failed`, func() {
+				hpdbService := newService()
+
+				result, err := hpdbService.WaitForTask(context.Background(), clusterID, taskID, nil)
+				Expect(result).ToNot(BeNil())
+				Expect(err).ToNot(BeNil())
+
+				var taskFailedErr *hpdbv3.TaskFailedError
+				Expect(errors.As(err, &taskFailedErr)).To(BeTrue())
+				Expect(taskFailedErr.ClusterID).To(Equal(clusterID))
+				Expect(taskFailedErr.TaskID).To(Equal(taskID))
+				Expect(taskFailedErr.Reason).To(Equal("scale failed"))
+				Expect(taskFailedErr.Nodes).To(Equal([]hpdbv3.TaskNodeFailure{{NodeID: "node-2", Reason: "disk full"}}))
+				Expect(taskFailedErr.Task).To(Equal(result))
+				Expect(err.Error()).To(ContainSubstring("scale failed"))
+				Expect(err.Error()).To(ContainSubstring("node node-2: disk full"))
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+		Context(`Using mock server endpoint with error response`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(404)
+					fmt.Fprintf(res, "%s", `{"errors": [{"message": "task not found"}]}`)
+				}))
+			})
+			It(`Invoke WaitForTask with error: Operation request error`, func() {
+				hpdbService := newService()
+
+				result, err := hpdbService.WaitForTask(context.Background(), clusterID, taskID, nil)
+				Expect(err).ToNot(BeNil())
+				Expect(result).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+})
@@ -0,0 +1,207 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// timestampLayouts are the layouts of the timestamps returned by the service, in the order in which they are tried.
+// The layouts without a time zone are in UTC.
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999 -0700 MST",
+	"2006-01-02 15:04:05.999999999 -0700",
+	"2006-01-02 15:04:05.999999999",
+	time.RFC1123,
+	time.RFC1123Z,
+	time.UnixDate,
+	"2006-01-02",
+}
+
+// ParseTimestamp parses a timestamp returned by the service, such as 2019-01-01T12:00:00.000Z,
+// 2019-01-01 12:00:00 +0000 UTC or Tue, 01 Jan 2019 12:00:00 GMT. The timestamps without a time zone are in UTC. The
+// result is in UTC.
+func ParseTimestamp(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid timestamp %q", value)
+}
+
+// parseTimestampField parses an optional timestamp. It returns the zero time if the timestamp is not set.
+func parseTimestampField(value *string) (time.Time, error) {
+	if value == nil || strings.TrimSpace(*value) == "" {
+		return time.Time{}, nil
+	}
+	return ParseTimestamp(*value)
+}
+
+// GetCreatedAt returns the time when the backup was created, or the zero time if it is not set.
+func (backup *Backup) GetCreatedAt() (time.Time, error) {
+	return parseTimestampField(backup.CreatedAt)
+}
+
+// GetCreatedAt returns the time when the cluster was created, or the zero time if it is not set.
+func (cluster *Cluster) GetCreatedAt() (time.Time, error) {
+	return parseTimestampField(cluster.CreatedAt)
+}
+
+// GetUpdatedAt returns the time when the cluster was updated, or the zero time if it is not set.
+func (cluster *Cluster) GetUpdatedAt() (time.Time, error) {
+	return parseTimestampField(cluster.UpdatedAt)
+}
+
+// GetCreatedAt returns the time when the node was created, or the zero time if it is not set.
+func (node *Node) GetCreatedAt() (time.Time, error) {
+	return parseTimestampField(node.CreatedAt)
+}
+
+// GetUpdatedAt returns the time when the node was updated, or the zero time if it is not set.
+func (node *Node) GetUpdatedAt() (time.Time, error) {
+	return parseTimestampField(node.UpdatedAt)
+}
+
+// GetLastModified returns the time when the log file was last modified, or the zero time if it is not set.
+func (log *Log) GetLastModified() (time.Time, error) {
+	return parseTimestampField(log.LastModified)
+}
+
+// GetStartedAt returns the time when the task started, or the zero time if it is not set.
+func (task *Task) GetStartedAt() (time.Time, error) {
+	return parseTimestampField(task.StartedAt)
+}
+
+// GetFinishedAt returns the time when the task finished, or the zero time if it has not finished.
+func (task *Task) GetFinishedAt() (time.Time, error) {
+	return parseTimestampField(task.FinishedAt)
+}
+
+// Duration returns how long the task ran. For a task which has not finished, it returns the time elapsed since the task
+// started.
+func (task *Task) Duration() (time.Duration, error) {
+	return taskDuration(task.StartedAt, task.FinishedAt)
+}
+
+// GetStartedAt returns the time when the task started, or the zero time if it is not set.
+func (taskItem *TaskItem) GetStartedAt() (time.Time, error) {
+	return parseTimestampField(taskItem.StartedAt)
+}
+
+// GetFinishedAt returns the time when the task finished, or the zero time if it has not finished.
+func (taskItem *TaskItem) GetFinishedAt() (time.Time, error) {
+	return parseTimestampField(taskItem.FinishedAt)
+}
+
+// Duration returns how long the task ran. For a task which has not finished, it returns the time elapsed since the task
+// started.
+func (taskItem *TaskItem) Duration() (time.Duration, error) {
+	return taskDuration(taskItem.StartedAt, taskItem.FinishedAt)
+}
+
+// GetStartedAt returns the time when the task started on the node, or the zero time if it is not set.
+func (taskNode *TaskNode) GetStartedAt() (time.Time, error) {
+	return parseTimestampField(taskNode.StartedAt)
+}
+
+// GetFinishedAt returns the time when the task finished on the node, or the zero time if it has not finished.
+func (taskNode *TaskNode) GetFinishedAt() (time.Time, error) {
+	return parseTimestampField(taskNode.FinishedAt)
+}
+
+// taskDuration returns the time between the start and the end of a task, or the time elapsed since its start if it has
+// no end.
+func taskDuration(startedAt *string, finishedAt *string) (time.Duration, error) {
+	started, err := parseTimestampField(startedAt)
+	if err != nil {
+		return 0, err
+	}
+	if started.IsZero() {
+		return 0, fmt.Errorf("the task has not started")
+	}
+	finished, err := parseTimestampField(finishedAt)
+	if err != nil {
+		return 0, err
+	}
+	if finished.IsZero() {
+		return time.Since(started), nil
+	}
+	return finished.Sub(started), nil
+}
+
+// SortBackupsByCreatedAt sorts backups from the oldest to the most recent. The backups with a creation time which is not
+// set or cannot be parsed are placed last, in their original order.
+func SortBackupsByCreatedAt(backups []Backup) {
+	sortByTimestamp(len(backups), func(i int) *string { return backups[i].CreatedAt }, func(i, j int) {
+		backups[i], backups[j] = backups[j], backups[i]
+	})
+}
+
+// SortTasksByStartedAt sorts tasks from the oldest to the most recent. The tasks with a start time which is not set or
+// cannot be parsed are placed last, in their original order.
+func SortTasksByStartedAt(tasks []TaskItem) {
+	sortByTimestamp(len(tasks), func(i int) *string { return tasks[i].StartedAt }, func(i, j int) {
+		tasks[i], tasks[j] = tasks[j], tasks[i]
+	})
+}
+
+// SortLogsByLastModified sorts log files from the least to the most recently modified. The log files with a modification
+// time which is not set or cannot be parsed are placed last, in their original order.
+func SortLogsByLastModified(logs []Log) {
+	sortByTimestamp(len(logs), func(i int) *string { return logs[i].LastModified }, func(i, j int) {
+		logs[i], logs[j] = logs[j], logs[i]
+	})
+}
+
+// timestampSorter sorts the elements of a slice by a timestamp which is parsed once per element.
+type timestampSorter struct {
+	times []time.Time
+	swap  func(i, j int)
+}
+
+func (s *timestampSorter) Len() int {
+	return len(s.times)
+}
+
+func (s *timestampSorter) Less(i, j int) bool {
+	if s.times[i].IsZero() || s.times[j].IsZero() {
+		return !s.times[i].IsZero() && s.times[j].IsZero()
+	}
+	return s.times[i].Before(s.times[j])
+}
+
+func (s *timestampSorter) Swap(i, j int) {
+	s.times[i], s.times[j] = s.times[j], s.times[i]
+	s.swap(i, j)
+}
+
+func sortByTimestamp(n int, timestamp func(i int) *string, swap func(i, j int)) {
+	s := &timestampSorter{times: make([]time.Time, n), swap: swap}
+	for i := range s.times {
+		// The timestamps which cannot be parsed are treated as not set
+		s.times[i], _ = parseTimestampField(timestamp(i))
+	}
+	sort.Stable(s)
+}
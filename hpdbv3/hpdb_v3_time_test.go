@@ -0,0 +1,140 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3_test
+
+import (
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`HpdbV3 timestamps`, func() {
+	Describe(`ParseTimestamp(value string)`, func() {
+		It(`Invoke ParseTimestamp successfully`, func() {
+			expected := time.Date(2019, time.January, 1, 12, 0, 0, 0, time.UTC)
+			for _, value := range []string{
+				"2019-01-01T12:00:00.000Z",
+				"2019-01-01T12:00:00Z",
+				"2019-01-01T14:00:00+02:00",
+				"2019-01-01T12:00:00",
+				"2019-01-01 12:00:00",
+				"2019-01-01 12:00:00.000000",
+				"2019-01-01 12:00:00+00:00",
+				"2019-01-01 12:00:00 +0000 UTC",
+				"2019-01-01 13:00:00 +0100",
+				"Tue, 01 Jan 2019 12:00:00 GMT",
+				"Tue, 01 Jan 2019 12:00:00 +0000",
+				" 2019-01-01T12:00:00Z ",
+			} {
+				t, err := hpdbv3.ParseTimestamp(value)
+				Expect(err).To(BeNil(), value)
+				Expect(t).To(Equal(expected), value)
+			}
+
+			t, err := hpdbv3.ParseTimestamp("2019-01-01")
+			Expect(err).To(BeNil())
+			Expect(t).To(Equal(time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)))
+		})
+		It(`Invoke ParseTimestamp with error: Invalid timestamp`, func() {
+			for _, value := range []string{"", "CreatedAt", "2019-13-01T12:00:00Z", "01/01/2019"} {
+				_, err := hpdbv3.ParseTimestamp(value)
+				Expect(err).ToNot(BeNil(), value)
+			}
+		})
+	})
+	Describe(`Timestamp accessors`, func() {
+		It(`Invoke the timestamp accessors successfully`, func() {
+			cluster := &hpdbv3.Cluster{
+				CreatedAt: core.StringPtr("2019-01-01T12:00:00Z"),
+				Nodes:     []hpdbv3.Node{{CreatedAt: core.StringPtr("2019-01-01 12:00:00")}},
+			}
+			createdAt, err := cluster.GetCreatedAt()
+			Expect(err).To(BeNil())
+			Expect(createdAt).To(Equal(time.Date(2019, time.January, 1, 12, 0, 0, 0, time.UTC)))
+			nodeCreatedAt, err := cluster.Nodes[0].GetCreatedAt()
+			Expect(err).To(BeNil())
+			Expect(nodeCreatedAt).To(Equal(createdAt))
+
+			// A timestamp which is not set is the zero time
+			updatedAt, err := cluster.GetUpdatedAt()
+			Expect(err).To(BeNil())
+			Expect(updatedAt.IsZero()).To(BeTrue())
+
+			_, err = (&hpdbv3.Log{LastModified: core.StringPtr("LastModified")}).GetLastModified()
+			Expect(err).ToNot(BeNil())
+		})
+		It(`Invoke Task.Duration successfully`, func() {
+			task := &hpdbv3.Task{
+				StartedAt:  core.StringPtr("2019-01-01T12:00:00Z"),
+				FinishedAt: core.StringPtr("2019-01-01T12:05:30.500Z"),
+			}
+			duration, err := task.Duration()
+			Expect(err).To(BeNil())
+			Expect(duration).To(Equal(5*time.Minute + 30500*time.Millisecond))
+
+			// A running task has run since it started
+			taskItem := &hpdbv3.TaskItem{StartedAt: core.StringPtr(time.Now().Add(-time.Hour).Format(time.RFC3339))}
+			duration, err = taskItem.Duration()
+			Expect(err).To(BeNil())
+			Expect(duration).To(BeNumerically("~", time.Hour, time.Minute))
+		})
+		It(`Invoke Task.Duration with error: Invalid task`, func() {
+			_, err := new(hpdbv3.Task).Duration()
+			Expect(err).ToNot(BeNil())
+
+			_, err = (&hpdbv3.Task{StartedAt: core.StringPtr("2019-01-01T12:00:00Z"), FinishedAt: core.StringPtr("FinishedAt")}).Duration()
+			Expect(err).ToNot(BeNil())
+		})
+	})
+	Describe(`Sort helpers`, func() {
+		It(`Invoke SortBackupsByCreatedAt successfully`, func() {
+			backups := []hpdbv3.Backup{
+				{ID: core.StringPtr("invalid"), CreatedAt: core.StringPtr("CreatedAt")},
+				{ID: core.StringPtr("second"), CreatedAt: core.StringPtr("2019-01-02 00:00:00")},
+				{ID: core.StringPtr("unset")},
+				{ID: core.StringPtr("third"), CreatedAt: core.StringPtr("2019-01-03T00:00:00Z")},
+				{ID: core.StringPtr("first"), CreatedAt: core.StringPtr("2019-01-02T01:00:00+02:00")},
+			}
+			hpdbv3.SortBackupsByCreatedAt(backups)
+			var ids []string
+			for _, backup := range backups {
+				ids = append(ids, *backup.ID)
+			}
+			Expect(ids).To(Equal([]string{"first", "second", "third", "invalid", "unset"}))
+		})
+		It(`Invoke SortTasksByStartedAt and SortLogsByLastModified successfully`, func() {
+			tasks := []hpdbv3.TaskItem{
+				{ID: core.StringPtr("task-2"), StartedAt: core.StringPtr("2019-01-01T12:00:01Z")},
+				{ID: core.StringPtr("task-1"), StartedAt: core.StringPtr("2019-01-01T12:00:00Z")},
+			}
+			hpdbv3.SortTasksByStartedAt(tasks)
+			Expect(*tasks[0].ID).To(Equal("task-1"))
+			Expect(*tasks[1].ID).To(Equal("task-2"))
+
+			logs := []hpdbv3.Log{
+				{Filename: core.StringPtr("postgresql-2.log"), LastModified: core.StringPtr("Wed, 02 Jan 2019 00:00:00 GMT")},
+				{Filename: core.StringPtr("postgresql-1.log"), LastModified: core.StringPtr("Tue, 01 Jan 2019 00:00:00 GMT")},
+			}
+			hpdbv3.SortLogsByLastModified(logs)
+			Expect(*logs[0].Filename).To(Equal("postgresql-1.log"))
+			Expect(*logs[1].Filename).To(Equal("postgresql-2.log"))
+		})
+	})
+})
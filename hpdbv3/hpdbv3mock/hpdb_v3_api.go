@@ -0,0 +1,735 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package hpdbv3mock
+
+import (
+	context "context"
+
+	core "github.com/IBM/go-sdk-core/v5/core"
+	hpdbv3 "github.com/IBM/hpdb-go-sdk/hpdbv3"
+
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// HpdbV3API is an autogenerated mock type for the HpdbV3API type
+type HpdbV3API struct {
+	mock.Mock
+}
+
+// DisableCosBackupWithContext provides a mock function with given fields: ctx, disableCosBackupOptions
+func (_m *HpdbV3API) DisableCosBackupWithContext(ctx context.Context, disableCosBackupOptions *hpdbv3.DisableCosBackupOptions) (*hpdbv3.TaskID, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, disableCosBackupOptions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisableCosBackupWithContext")
+	}
+
+	var r0 *hpdbv3.TaskID
+	var r1 *core.DetailedResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.DisableCosBackupOptions) (*hpdbv3.TaskID, *core.DetailedResponse, error)); ok {
+		return rf(ctx, disableCosBackupOptions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.DisableCosBackupOptions) *hpdbv3.TaskID); ok {
+		r0 = rf(ctx, disableCosBackupOptions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*hpdbv3.TaskID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *hpdbv3.DisableCosBackupOptions) *core.DetailedResponse); ok {
+		r1 = rf(ctx, disableCosBackupOptions)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*core.DetailedResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *hpdbv3.DisableCosBackupOptions) error); ok {
+		r2 = rf(ctx, disableCosBackupOptions)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// EnableCosBackupWithContext provides a mock function with given fields: ctx, enableCosBackupOptions
+func (_m *HpdbV3API) EnableCosBackupWithContext(ctx context.Context, enableCosBackupOptions *hpdbv3.EnableCosBackupOptions) (*hpdbv3.TaskID, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, enableCosBackupOptions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnableCosBackupWithContext")
+	}
+
+	var r0 *hpdbv3.TaskID
+	var r1 *core.DetailedResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.EnableCosBackupOptions) (*hpdbv3.TaskID, *core.DetailedResponse, error)); ok {
+		return rf(ctx, enableCosBackupOptions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.EnableCosBackupOptions) *hpdbv3.TaskID); ok {
+		r0 = rf(ctx, enableCosBackupOptions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*hpdbv3.TaskID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *hpdbv3.EnableCosBackupOptions) *core.DetailedResponse); ok {
+		r1 = rf(ctx, enableCosBackupOptions)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*core.DetailedResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *hpdbv3.EnableCosBackupOptions) error); ok {
+		r2 = rf(ctx, enableCosBackupOptions)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetBackupConfigWithContext provides a mock function with given fields: ctx, getBackupConfigOptions
+func (_m *HpdbV3API) GetBackupConfigWithContext(ctx context.Context, getBackupConfigOptions *hpdbv3.GetBackupConfigOptions) (*hpdbv3.GetBackupConfigResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getBackupConfigOptions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBackupConfigWithContext")
+	}
+
+	var r0 *hpdbv3.GetBackupConfigResponse
+	var r1 *core.DetailedResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.GetBackupConfigOptions) (*hpdbv3.GetBackupConfigResponse, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getBackupConfigOptions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.GetBackupConfigOptions) *hpdbv3.GetBackupConfigResponse); ok {
+		r0 = rf(ctx, getBackupConfigOptions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*hpdbv3.GetBackupConfigResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *hpdbv3.GetBackupConfigOptions) *core.DetailedResponse); ok {
+		r1 = rf(ctx, getBackupConfigOptions)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*core.DetailedResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *hpdbv3.GetBackupConfigOptions) error); ok {
+		r2 = rf(ctx, getBackupConfigOptions)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetClusterWithContext provides a mock function with given fields: ctx, getClusterOptions
+func (_m *HpdbV3API) GetClusterWithContext(ctx context.Context, getClusterOptions *hpdbv3.GetClusterOptions) (*hpdbv3.Cluster, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getClusterOptions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClusterWithContext")
+	}
+
+	var r0 *hpdbv3.Cluster
+	var r1 *core.DetailedResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.GetClusterOptions) (*hpdbv3.Cluster, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getClusterOptions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.GetClusterOptions) *hpdbv3.Cluster); ok {
+		r0 = rf(ctx, getClusterOptions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*hpdbv3.Cluster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *hpdbv3.GetClusterOptions) *core.DetailedResponse); ok {
+		r1 = rf(ctx, getClusterOptions)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*core.DetailedResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *hpdbv3.GetClusterOptions) error); ok {
+		r2 = rf(ctx, getClusterOptions)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetConfigurationWithContext provides a mock function with given fields: ctx, getConfigurationOptions
+func (_m *HpdbV3API) GetConfigurationWithContext(ctx context.Context, getConfigurationOptions *hpdbv3.GetConfigurationOptions) (*hpdbv3.Configuration, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getConfigurationOptions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConfigurationWithContext")
+	}
+
+	var r0 *hpdbv3.Configuration
+	var r1 *core.DetailedResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.GetConfigurationOptions) (*hpdbv3.Configuration, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getConfigurationOptions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.GetConfigurationOptions) *hpdbv3.Configuration); ok {
+		r0 = rf(ctx, getConfigurationOptions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*hpdbv3.Configuration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *hpdbv3.GetConfigurationOptions) *core.DetailedResponse); ok {
+		r1 = rf(ctx, getConfigurationOptions)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*core.DetailedResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *hpdbv3.GetConfigurationOptions) error); ok {
+		r2 = rf(ctx, getConfigurationOptions)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetCosBackupConfigWithContext provides a mock function with given fields: ctx, getCosBackupConfigOptions
+func (_m *HpdbV3API) GetCosBackupConfigWithContext(ctx context.Context, getCosBackupConfigOptions *hpdbv3.GetCosBackupConfigOptions) (*hpdbv3.GetCosBackupConfigResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getCosBackupConfigOptions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCosBackupConfigWithContext")
+	}
+
+	var r0 *hpdbv3.GetCosBackupConfigResponse
+	var r1 *core.DetailedResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.GetCosBackupConfigOptions) (*hpdbv3.GetCosBackupConfigResponse, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getCosBackupConfigOptions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.GetCosBackupConfigOptions) *hpdbv3.GetCosBackupConfigResponse); ok {
+		r0 = rf(ctx, getCosBackupConfigOptions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*hpdbv3.GetCosBackupConfigResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *hpdbv3.GetCosBackupConfigOptions) *core.DetailedResponse); ok {
+		r1 = rf(ctx, getCosBackupConfigOptions)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*core.DetailedResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *hpdbv3.GetCosBackupConfigOptions) error); ok {
+		r2 = rf(ctx, getCosBackupConfigOptions)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetLogWithContext provides a mock function with given fields: ctx, getLogOptions
+func (_m *HpdbV3API) GetLogWithContext(ctx context.Context, getLogOptions *hpdbv3.GetLogOptions) (io.ReadCloser, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getLogOptions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLogWithContext")
+	}
+
+	var r0 io.ReadCloser
+	var r1 *core.DetailedResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.GetLogOptions) (io.ReadCloser, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getLogOptions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.GetLogOptions) io.ReadCloser); ok {
+		r0 = rf(ctx, getLogOptions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *hpdbv3.GetLogOptions) *core.DetailedResponse); ok {
+		r1 = rf(ctx, getLogOptions)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*core.DetailedResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *hpdbv3.GetLogOptions) error); ok {
+		r2 = rf(ctx, getLogOptions)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetTaskWithContext provides a mock function with given fields: ctx, getTaskOptions
+func (_m *HpdbV3API) GetTaskWithContext(ctx context.Context, getTaskOptions *hpdbv3.GetTaskOptions) (*hpdbv3.Task, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getTaskOptions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTaskWithContext")
+	}
+
+	var r0 *hpdbv3.Task
+	var r1 *core.DetailedResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.GetTaskOptions) (*hpdbv3.Task, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getTaskOptions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.GetTaskOptions) *hpdbv3.Task); ok {
+		r0 = rf(ctx, getTaskOptions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*hpdbv3.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *hpdbv3.GetTaskOptions) *core.DetailedResponse); ok {
+		r1 = rf(ctx, getTaskOptions)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*core.DetailedResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *hpdbv3.GetTaskOptions) error); ok {
+		r2 = rf(ctx, getTaskOptions)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetUserWithContext provides a mock function with given fields: ctx, getUserOptions
+func (_m *HpdbV3API) GetUserWithContext(ctx context.Context, getUserOptions *hpdbv3.GetUserOptions) (*hpdbv3.UserDetails, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, getUserOptions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserWithContext")
+	}
+
+	var r0 *hpdbv3.UserDetails
+	var r1 *core.DetailedResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.GetUserOptions) (*hpdbv3.UserDetails, *core.DetailedResponse, error)); ok {
+		return rf(ctx, getUserOptions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.GetUserOptions) *hpdbv3.UserDetails); ok {
+		r0 = rf(ctx, getUserOptions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*hpdbv3.UserDetails)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *hpdbv3.GetUserOptions) *core.DetailedResponse); ok {
+		r1 = rf(ctx, getUserOptions)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*core.DetailedResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *hpdbv3.GetUserOptions) error); ok {
+		r2 = rf(ctx, getUserOptions)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListBackupsWithContext provides a mock function with given fields: ctx, listBackupsOptions
+func (_m *HpdbV3API) ListBackupsWithContext(ctx context.Context, listBackupsOptions *hpdbv3.ListBackupsOptions) (*hpdbv3.ListBackupsResponse, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listBackupsOptions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBackupsWithContext")
+	}
+
+	var r0 *hpdbv3.ListBackupsResponse
+	var r1 *core.DetailedResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.ListBackupsOptions) (*hpdbv3.ListBackupsResponse, *core.DetailedResponse, error)); ok {
+		return rf(ctx, listBackupsOptions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.ListBackupsOptions) *hpdbv3.ListBackupsResponse); ok {
+		r0 = rf(ctx, listBackupsOptions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*hpdbv3.ListBackupsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *hpdbv3.ListBackupsOptions) *core.DetailedResponse); ok {
+		r1 = rf(ctx, listBackupsOptions)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*core.DetailedResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *hpdbv3.ListBackupsOptions) error); ok {
+		r2 = rf(ctx, listBackupsOptions)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListDatabasesWithContext provides a mock function with given fields: ctx, listDatabasesOptions
+func (_m *HpdbV3API) ListDatabasesWithContext(ctx context.Context, listDatabasesOptions *hpdbv3.ListDatabasesOptions) (*hpdbv3.Databases, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listDatabasesOptions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDatabasesWithContext")
+	}
+
+	var r0 *hpdbv3.Databases
+	var r1 *core.DetailedResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.ListDatabasesOptions) (*hpdbv3.Databases, *core.DetailedResponse, error)); ok {
+		return rf(ctx, listDatabasesOptions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.ListDatabasesOptions) *hpdbv3.Databases); ok {
+		r0 = rf(ctx, listDatabasesOptions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*hpdbv3.Databases)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *hpdbv3.ListDatabasesOptions) *core.DetailedResponse); ok {
+		r1 = rf(ctx, listDatabasesOptions)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*core.DetailedResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *hpdbv3.ListDatabasesOptions) error); ok {
+		r2 = rf(ctx, listDatabasesOptions)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListNodeLogsWithContext provides a mock function with given fields: ctx, listNodeLogsOptions
+func (_m *HpdbV3API) ListNodeLogsWithContext(ctx context.Context, listNodeLogsOptions *hpdbv3.ListNodeLogsOptions) (*hpdbv3.LogList, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listNodeLogsOptions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNodeLogsWithContext")
+	}
+
+	var r0 *hpdbv3.LogList
+	var r1 *core.DetailedResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.ListNodeLogsOptions) (*hpdbv3.LogList, *core.DetailedResponse, error)); ok {
+		return rf(ctx, listNodeLogsOptions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.ListNodeLogsOptions) *hpdbv3.LogList); ok {
+		r0 = rf(ctx, listNodeLogsOptions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*hpdbv3.LogList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *hpdbv3.ListNodeLogsOptions) *core.DetailedResponse); ok {
+		r1 = rf(ctx, listNodeLogsOptions)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*core.DetailedResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *hpdbv3.ListNodeLogsOptions) error); ok {
+		r2 = rf(ctx, listNodeLogsOptions)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListTasksWithContext provides a mock function with given fields: ctx, listTasksOptions
+func (_m *HpdbV3API) ListTasksWithContext(ctx context.Context, listTasksOptions *hpdbv3.ListTasksOptions) (*hpdbv3.Tasks, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listTasksOptions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTasksWithContext")
+	}
+
+	var r0 *hpdbv3.Tasks
+	var r1 *core.DetailedResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.ListTasksOptions) (*hpdbv3.Tasks, *core.DetailedResponse, error)); ok {
+		return rf(ctx, listTasksOptions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.ListTasksOptions) *hpdbv3.Tasks); ok {
+		r0 = rf(ctx, listTasksOptions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*hpdbv3.Tasks)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *hpdbv3.ListTasksOptions) *core.DetailedResponse); ok {
+		r1 = rf(ctx, listTasksOptions)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*core.DetailedResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *hpdbv3.ListTasksOptions) error); ok {
+		r2 = rf(ctx, listTasksOptions)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListUsersWithContext provides a mock function with given fields: ctx, listUsersOptions
+func (_m *HpdbV3API) ListUsersWithContext(ctx context.Context, listUsersOptions *hpdbv3.ListUsersOptions) (*hpdbv3.Users, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, listUsersOptions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUsersWithContext")
+	}
+
+	var r0 *hpdbv3.Users
+	var r1 *core.DetailedResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.ListUsersOptions) (*hpdbv3.Users, *core.DetailedResponse, error)); ok {
+		return rf(ctx, listUsersOptions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.ListUsersOptions) *hpdbv3.Users); ok {
+		r0 = rf(ctx, listUsersOptions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*hpdbv3.Users)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *hpdbv3.ListUsersOptions) *core.DetailedResponse); ok {
+		r1 = rf(ctx, listUsersOptions)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*core.DetailedResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *hpdbv3.ListUsersOptions) error); ok {
+		r2 = rf(ctx, listUsersOptions)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RestoreWithContext provides a mock function with given fields: ctx, restoreOptions
+func (_m *HpdbV3API) RestoreWithContext(ctx context.Context, restoreOptions *hpdbv3.RestoreOptions) (*hpdbv3.TaskID, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, restoreOptions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreWithContext")
+	}
+
+	var r0 *hpdbv3.TaskID
+	var r1 *core.DetailedResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.RestoreOptions) (*hpdbv3.TaskID, *core.DetailedResponse, error)); ok {
+		return rf(ctx, restoreOptions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.RestoreOptions) *hpdbv3.TaskID); ok {
+		r0 = rf(ctx, restoreOptions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*hpdbv3.TaskID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *hpdbv3.RestoreOptions) *core.DetailedResponse); ok {
+		r1 = rf(ctx, restoreOptions)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*core.DetailedResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *hpdbv3.RestoreOptions) error); ok {
+		r2 = rf(ctx, restoreOptions)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ScaleResourcesWithContext provides a mock function with given fields: ctx, scaleResourcesOptions
+func (_m *HpdbV3API) ScaleResourcesWithContext(ctx context.Context, scaleResourcesOptions *hpdbv3.ScaleResourcesOptions) (*hpdbv3.TaskID, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, scaleResourcesOptions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScaleResourcesWithContext")
+	}
+
+	var r0 *hpdbv3.TaskID
+	var r1 *core.DetailedResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.ScaleResourcesOptions) (*hpdbv3.TaskID, *core.DetailedResponse, error)); ok {
+		return rf(ctx, scaleResourcesOptions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.ScaleResourcesOptions) *hpdbv3.TaskID); ok {
+		r0 = rf(ctx, scaleResourcesOptions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*hpdbv3.TaskID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *hpdbv3.ScaleResourcesOptions) *core.DetailedResponse); ok {
+		r1 = rf(ctx, scaleResourcesOptions)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*core.DetailedResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *hpdbv3.ScaleResourcesOptions) error); ok {
+		r2 = rf(ctx, scaleResourcesOptions)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// UpdateBackupConfigWithContext provides a mock function with given fields: ctx, updateBackupConfigOptions
+func (_m *HpdbV3API) UpdateBackupConfigWithContext(ctx context.Context, updateBackupConfigOptions *hpdbv3.UpdateBackupConfigOptions) (*hpdbv3.TaskID, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateBackupConfigOptions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateBackupConfigWithContext")
+	}
+
+	var r0 *hpdbv3.TaskID
+	var r1 *core.DetailedResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.UpdateBackupConfigOptions) (*hpdbv3.TaskID, *core.DetailedResponse, error)); ok {
+		return rf(ctx, updateBackupConfigOptions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.UpdateBackupConfigOptions) *hpdbv3.TaskID); ok {
+		r0 = rf(ctx, updateBackupConfigOptions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*hpdbv3.TaskID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *hpdbv3.UpdateBackupConfigOptions) *core.DetailedResponse); ok {
+		r1 = rf(ctx, updateBackupConfigOptions)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*core.DetailedResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *hpdbv3.UpdateBackupConfigOptions) error); ok {
+		r2 = rf(ctx, updateBackupConfigOptions)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// UpdateConfigurationWithContext provides a mock function with given fields: ctx, updateConfigurationOptions
+func (_m *HpdbV3API) UpdateConfigurationWithContext(ctx context.Context, updateConfigurationOptions *hpdbv3.UpdateConfigurationOptions) (*hpdbv3.TaskID, *core.DetailedResponse, error) {
+	ret := _m.Called(ctx, updateConfigurationOptions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConfigurationWithContext")
+	}
+
+	var r0 *hpdbv3.TaskID
+	var r1 *core.DetailedResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.UpdateConfigurationOptions) (*hpdbv3.TaskID, *core.DetailedResponse, error)); ok {
+		return rf(ctx, updateConfigurationOptions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *hpdbv3.UpdateConfigurationOptions) *hpdbv3.TaskID); ok {
+		r0 = rf(ctx, updateConfigurationOptions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*hpdbv3.TaskID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *hpdbv3.UpdateConfigurationOptions) *core.DetailedResponse); ok {
+		r1 = rf(ctx, updateConfigurationOptions)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*core.DetailedResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *hpdbv3.UpdateConfigurationOptions) error); ok {
+		r2 = rf(ctx, updateConfigurationOptions)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// NewHpdbV3API creates a new instance of HpdbV3API. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHpdbV3API(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HpdbV3API {
+	mock := &HpdbV3API{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,51 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3mock_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3/hpdbv3mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var _ hpdbv3.HpdbV3API = (*hpdbv3mock.HpdbV3API)(nil)
+
+// clusterState is an example of code which depends on the service through the HpdbV3API interface.
+func clusterState(ctx context.Context, hpdb hpdbv3.HpdbV3API, clusterID string) (string, error) {
+	cluster, _, err := hpdb.GetClusterWithContext(ctx, &hpdbv3.GetClusterOptions{ClusterID: core.StringPtr(clusterID)})
+	if err != nil {
+		return "", err
+	}
+	return *cluster.State, nil
+}
+
+func TestHpdbV3API(t *testing.T) {
+	hpdb := hpdbv3mock.NewHpdbV3API(t)
+	hpdb.On("GetClusterWithContext", mock.Anything, mock.MatchedBy(func(options *hpdbv3.GetClusterOptions) bool {
+		return *options.ClusterID == "cluster-1"
+	})).Return(&hpdbv3.Cluster{State: core.StringPtr("RUNNING")}, &core.DetailedResponse{StatusCode: 200}, nil).Once()
+
+	state, err := clusterState(context.Background(), hpdb, "cluster-1")
+	require.Nil(t, err)
+	assert.Equal(t, "RUNNING", state)
+}
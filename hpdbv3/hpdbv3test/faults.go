@@ -0,0 +1,193 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3test
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Fault : A fault which the server injects into the requests it receives.
+//
+// A fault applies to the requests which match its Method and Route. It can delay the response, replace it with an
+// error status code, or truncate its body. For example, the following fault makes the next two requests for a task
+// fail with 503 Service Unavailable:
+//
+//	server.InjectFault(hpdbv3test.Fault{
+//		Method:     "GET",
+//		Route:      "/clusters/{}/tasks/{}",
+//		StatusCode: 503,
+//		Times:      2,
+//	})
+type Fault struct {
+	// The HTTP method of the requests to match, such as "GET". An empty method matches every method.
+	Method string
+
+	// The route of the requests to match, with "{}" in place of the path parameters, such as "/clusters/{}/tasks/{}".
+	// The /api/v3/{account_id} prefix of the service URL is not part of the route. An empty route matches every route.
+	Route string
+
+	// The number of matching requests the fault applies to. Zero applies the fault to every matching request until the
+	// faults are cleared.
+	Times int
+
+	// The real time to wait before responding. The wait ends early if the client cancels the request.
+	Delay time.Duration
+
+	// The status code of the error response which replaces the response of the route, such as 429, 500 or 503. Zero
+	// leaves the route to respond normally.
+	StatusCode int
+
+	// The number of seconds sent in the Retry-After header of 429 and 503 error responses.
+	RetryAfter int
+
+	// Whether to cut the body of the response in half, so that it is no longer valid JSON.
+	TruncateBody bool
+}
+
+// faultState holds an injected fault together with the number of requests it still applies to.
+type faultState struct {
+	fault     Fault
+	remaining int
+}
+
+// matches returns true if the fault applies to a request with the specified method and route.
+func (f *faultState) matches(method string, route string) bool {
+	if f.fault.Times > 0 && f.remaining <= 0 {
+		return false
+	}
+	return (f.fault.Method == "" || f.fault.Method == method) && (f.fault.Route == "" || f.fault.Route == route)
+}
+
+// InjectFault adds a fault to the server. When several faults match a request, the one injected first applies.
+func (s *Server) InjectFault(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &faultState{fault: fault, remaining: fault.Times})
+}
+
+// ClearFaults removes every fault from the server.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// Requests returns the requests received by the server, in the order they were received, as "METHOD /path" strings.
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// FailNextTaskOnNode makes the next task created by the server end in the FAILED state because of the node that is
+// indicated by its ID. The other nodes of the task succeed.
+func (s *Server) FailNextTaskOnNode(nodeID string, reason string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextTaskFails = &taskFailure{nodeID: nodeID, reason: reason}
+}
+
+// FailCluster moves the cluster that is indicated by its ID into the FAILED state with the specified reason. A failed
+// cluster rejects the operations which would create a task with 409 Conflict.
+func (s *Server) FailCluster(clusterID string, reason string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cluster := &s.mustCluster(clusterID).cluster
+	cluster.State = core.StringPtr("FAILED")
+	cluster.Reason = core.StringPtr(reason)
+	cluster.UpdatedAt = core.StringPtr(s.now.Format(timestampFormat))
+}
+
+// takeFault returns the fault which applies to a request, counting the request against it. The caller must hold s.mu.
+func (s *Server) takeFault(method string, route string) *Fault {
+	for _, f := range s.faults {
+		if f.matches(method, route) {
+			f.remaining--
+			fault := f.fault
+			return &fault
+		}
+	}
+	return nil
+}
+
+// serveFault writes the response of a request to which a fault applies, calling next to handle requests which are
+// not replaced by an error response.
+func serveFault(res http.ResponseWriter, req *http.Request, fault *Fault, next func(res http.ResponseWriter)) {
+	if fault.Delay > 0 {
+		timer := time.NewTimer(fault.Delay)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			return
+		}
+	}
+
+	if !fault.TruncateBody {
+		if fault.StatusCode != 0 {
+			writeFaultError(res, fault)
+			return
+		}
+		next(res)
+		return
+	}
+
+	recorder := &responseRecorder{header: make(http.Header), statusCode: http.StatusOK}
+	if fault.StatusCode != 0 {
+		writeFaultError(recorder, fault)
+	} else {
+		next(recorder)
+	}
+	for name, values := range recorder.header {
+		res.Header()[name] = values
+	}
+	body := recorder.body.Bytes()
+	res.WriteHeader(recorder.statusCode)
+	_, _ = res.Write(body[:len(body)/2])
+}
+
+// writeFaultError writes the error response of a fault.
+func writeFaultError(res http.ResponseWriter, fault *Fault) {
+	if fault.StatusCode == http.StatusTooManyRequests || fault.StatusCode == http.StatusServiceUnavailable {
+		res.Header().Set("Retry-After", strconv.Itoa(fault.RetryAfter))
+	}
+	writeError(res, fault.StatusCode, "injected_fault", http.StatusText(fault.StatusCode))
+}
+
+// responseRecorder buffers a response so that its body can be truncated.
+type responseRecorder struct {
+	header     http.Header
+	statusCode int
+	body       bytes.Buffer
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *responseRecorder) WriteHeader(statusCode int) {
+	r.statusCode = statusCode
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	return r.body.Write(b)
+}
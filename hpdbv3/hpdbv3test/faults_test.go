@@ -0,0 +1,138 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3test_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3/hpdbv3test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInjectErrorStatusCodes(t *testing.T) {
+	for _, statusCode := range []int{429, 500, 503} {
+		server, hpdb := newServer(t)
+		server.InjectFault(hpdbv3test.Fault{Method: "GET", Route: "/clusters/{}", StatusCode: statusCode, Times: 1})
+
+		_, response, err := hpdb.GetCluster(hpdb.NewGetClusterOptions(clusterID))
+		require.NotNil(t, err)
+		assert.Equal(t, statusCode, response.StatusCode)
+
+		// The fault only applies to the first request
+		cluster, _, err := hpdb.GetCluster(hpdb.NewGetClusterOptions(clusterID))
+		require.Nil(t, err)
+		assert.Equal(t, clusterID, *cluster.ID)
+	}
+}
+
+func TestInjectFaultIsRetried(t *testing.T) {
+	server, hpdb := newServer(t)
+	server.InjectFault(hpdbv3test.Fault{Route: "/clusters/{}", StatusCode: 503, Times: 2})
+	hpdb.Service.EnableRetries(3, time.Second)
+
+	cluster, response, err := hpdb.GetCluster(hpdb.NewGetClusterOptions(clusterID))
+	require.Nil(t, err)
+	assert.Equal(t, 200, response.StatusCode)
+	assert.Equal(t, clusterID, *cluster.ID)
+	assert.Len(t, server.Requests(), 3)
+}
+
+func TestInjectFaultMatchesRoute(t *testing.T) {
+	server, hpdb := newServer(t)
+	server.InjectFault(hpdbv3test.Fault{Method: "PATCH", Route: "/clusters/{}/resource", StatusCode: 500})
+
+	_, _, err := hpdb.GetCluster(hpdb.NewGetClusterOptions(clusterID))
+	require.Nil(t, err)
+	for i := 0; i < 2; i++ {
+		_, response, err := hpdb.ScaleResources(hpdb.NewScaleResourcesOptions(clusterID).SetResource(&hpdbv3.Resources{Cpu: core.Int64Ptr(int64(4))}))
+		require.NotNil(t, err)
+		assert.Equal(t, 500, response.StatusCode)
+	}
+
+	server.ClearFaults()
+	_, _, err = hpdb.ScaleResources(hpdb.NewScaleResourcesOptions(clusterID).SetResource(&hpdbv3.Resources{Cpu: core.Int64Ptr(int64(4))}))
+	require.Nil(t, err)
+	assert.Equal(t, []string{
+		"GET /clusters/" + clusterID,
+		"PATCH /clusters/" + clusterID + "/resource",
+		"PATCH /clusters/" + clusterID + "/resource",
+		"PATCH /clusters/" + clusterID + "/resource",
+	}, server.Requests())
+}
+
+func TestInjectSlowResponse(t *testing.T) {
+	server, hpdb := newServer(t)
+	server.InjectFault(hpdbv3test.Fault{Route: "/clusters/{}", Delay: time.Minute})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, _, err := hpdb.GetClusterWithContext(ctx, hpdb.NewGetClusterOptions(clusterID))
+	require.NotNil(t, err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+
+	// Other routes are not delayed while the request waits
+	_, _, err = hpdb.ListUsers(hpdb.NewListUsersOptions(clusterID))
+	require.Nil(t, err)
+}
+
+func TestInjectTruncatedBody(t *testing.T) {
+	server, hpdb := newServer(t)
+	server.InjectFault(hpdbv3test.Fault{Route: "/clusters/{}", TruncateBody: true, Times: 1})
+
+	cluster, response, err := hpdb.GetCluster(hpdb.NewGetClusterOptions(clusterID))
+	require.NotNil(t, err)
+	assert.Nil(t, cluster)
+	assert.Equal(t, 200, response.StatusCode)
+}
+
+func TestFailNextTaskOnNode(t *testing.T) {
+	server, hpdb := newServer(t)
+	server.AdvanceOnRequest(10 * time.Second)
+	nodeID := clusterID + "-node-2"
+	server.FailNextTaskOnNode(nodeID, "disk full")
+
+	waitForTaskOptions := hpdb.NewWaitForTaskOptions().SetInterval(time.Millisecond)
+	scaleResourcesOptions := hpdb.NewScaleResourcesOptions(clusterID).SetResource(&hpdbv3.Resources{Cpu: core.Int64Ptr(int64(4))})
+	_, _, err := hpdb.ScaleResourcesAndWait(context.Background(), scaleResourcesOptions, waitForTaskOptions)
+
+	var taskFailedErr *hpdbv3.TaskFailedError
+	require.True(t, errors.As(err, &taskFailedErr))
+	assert.Equal(t, []hpdbv3.TaskNodeFailure{{NodeID: nodeID, Reason: "disk full"}}, taskFailedErr.Nodes)
+	assert.Equal(t, "SUCCEEDED", *taskFailedErr.Task.Nodes[0].State)
+	assert.Equal(t, "FAILED", *taskFailedErr.Task.Nodes[1].State)
+}
+
+func TestFailCluster(t *testing.T) {
+	server, hpdb := newServer(t)
+	server.FailCluster(clusterID, "storage is not available")
+
+	cluster, _, err := hpdb.GetCluster(hpdb.NewGetClusterOptions(clusterID))
+	require.Nil(t, err)
+	assert.Equal(t, "FAILED", *cluster.State)
+	assert.Equal(t, "storage is not available", *cluster.Reason)
+
+	_, response, err := hpdb.ScaleResources(hpdb.NewScaleResourcesOptions(clusterID).SetResource(&hpdbv3.Resources{Cpu: core.Int64Ptr(int64(4))}))
+	require.NotNil(t, err)
+	assert.Equal(t, 409, response.StatusCode)
+	assert.Empty(t, server.Tasks(clusterID))
+}
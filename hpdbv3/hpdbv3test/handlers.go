@@ -0,0 +1,486 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+)
+
+// handler returns the http.Handler which serves the HpdbV3 routes.
+func (s *Server) handler() http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		segments := splitPath(req.URL.EscapedPath())
+
+		s.mu.Lock()
+		s.requests = append(s.requests, req.Method+" "+req.URL.Path)
+		if s.requestAdvance > 0 {
+			s.advance(s.requestAdvance)
+		}
+		fault := s.takeFault(req.Method, routePattern(segments))
+		if fault == nil {
+			defer s.mu.Unlock()
+			s.route(res, req, segments)
+			return
+		}
+		s.mu.Unlock()
+
+		// The lock is not held while a fault delays the response, so that other requests are not blocked
+		serveFault(res, req, fault, func(res http.ResponseWriter) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.route(res, req, segments)
+		})
+	})
+}
+
+// splitPath returns the segments of a request path, without the /api/v3/{account_id} prefix of the service URL.
+func splitPath(path string) []string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) >= 3 && segments[0] == "api" && segments[1] == "v3" {
+		segments = segments[3:]
+	}
+	return segments
+}
+
+// route dispatches a request to the handler of its route. The caller must hold s.mu.
+func (s *Server) route(res http.ResponseWriter, req *http.Request, segments []string) {
+	route := req.Method + " " + routePattern(segments)
+	if len(segments) >= 2 && segments[0] == "nodes" {
+		switch route {
+		case "GET /nodes/{}/logs":
+			s.listNodeLogs(res, segments[1])
+		case "GET /nodes/{}/logs/{}":
+			s.getLog(res, req, segments[1], segments[3])
+		default:
+			writeError(res, http.StatusNotFound, "not_found", "route not found: "+req.Method+" "+req.URL.Path)
+		}
+		return
+	}
+	if len(segments) < 2 || segments[0] != "clusters" {
+		writeError(res, http.StatusNotFound, "not_found", "route not found: "+req.Method+" "+req.URL.Path)
+		return
+	}
+
+	cluster, ok := s.clusters[segments[1]]
+	if !ok {
+		writeError(res, http.StatusNotFound, "cluster_not_found", "cluster not found: "+segments[1])
+		return
+	}
+	switch route {
+	case "GET /clusters/{}":
+		writeJSON(res, http.StatusOK, cluster.cluster)
+	case "GET /clusters/{}/users":
+		s.listUsers(res, cluster)
+	case "GET /clusters/{}/users/{}":
+		s.getUser(res, cluster, segments[3])
+	case "GET /clusters/{}/databases":
+		s.listDatabases(res, cluster)
+	case "PATCH /clusters/{}/resource":
+		s.scaleResources(res, req, cluster)
+	case "GET /clusters/{}/configuration":
+		s.getConfiguration(res, cluster)
+	case "PATCH /clusters/{}/configuration":
+		s.updateConfiguration(res, req, cluster)
+	case "GET /clusters/{}/tasks":
+		s.listTasks(res, cluster)
+	case "GET /clusters/{}/tasks/{}":
+		s.getTask(res, cluster, segments[3])
+	case "GET /clusters/{}/backups":
+		writeJSON(res, http.StatusOK, hpdbv3.ListBackupsResponse{Backups: cluster.backups})
+	case "POST /clusters/{}/backups/cos/enable":
+		s.enableCosBackup(res, req, cluster)
+	case "POST /clusters/{}/backups/cos/disable":
+		s.disableCosBackup(res, cluster)
+	case "GET /clusters/{}/backups/cos/configuration":
+		s.getCosBackupConfig(res, cluster)
+	case "GET /clusters/{}/backups/configuration":
+		s.getBackupConfig(res, cluster)
+	case "PUT /clusters/{}/backups/configuration":
+		s.updateBackupConfig(res, req, cluster)
+	case "POST /clusters/{}/restore":
+		s.restore(res, req, cluster)
+	default:
+		writeError(res, http.StatusNotFound, "not_found", "route not found: "+req.Method+" "+req.URL.Path)
+	}
+}
+
+// parameterCollections are the path segments which are followed by a path parameter.
+var parameterCollections = map[string]bool{
+	"clusters": true,
+	"users":    true,
+	"tasks":    true,
+	"nodes":    true,
+	"logs":     true,
+}
+
+// routePattern replaces the path parameters of a route, which follow a collection segment such as "clusters", with "{}".
+func routePattern(segments []string) string {
+	pattern := make([]string, len(segments))
+	for i, segment := range segments {
+		if i > 0 && parameterCollections[segments[i-1]] {
+			segment = "{}"
+		}
+		pattern[i] = segment
+	}
+	return "/" + strings.Join(pattern, "/")
+}
+
+func (s *Server) listUsers(res http.ResponseWriter, cluster *clusterState) {
+	users := hpdbv3.Users{Users: []hpdbv3.User{}}
+	for _, user := range cluster.users {
+		users.Users = append(users.Users, hpdbv3.User{
+			Name:           user.Name,
+			AuthDb:         user.AuthDb,
+			RoleAttributes: user.RoleAttributes,
+		})
+	}
+	writeJSON(res, http.StatusOK, users)
+}
+
+func (s *Server) getUser(res http.ResponseWriter, cluster *clusterState, dbUserID string) {
+	for _, user := range cluster.users {
+		name := core.StringNilMapper(user.Name)
+		if user.AuthDb != nil {
+			// MongoDB users are identified by {auth_db}.{name}
+			name = *user.AuthDb + "." + name
+		}
+		if name == dbUserID {
+			writeJSON(res, http.StatusOK, user)
+			return
+		}
+	}
+	writeError(res, http.StatusNotFound, "user_not_found", "user not found: "+dbUserID)
+}
+
+func (s *Server) listDatabases(res http.ResponseWriter, cluster *clusterState) {
+	databases := hpdbv3.Databases{TotalSize: core.Int64Ptr(int64(0)), Databases: []hpdbv3.Database{}}
+	for _, database := range cluster.databases {
+		if database.SizeOnDisk != nil {
+			*databases.TotalSize += *database.SizeOnDisk
+		}
+		databases.Databases = append(databases.Databases, database)
+	}
+	writeJSON(res, http.StatusOK, databases)
+}
+
+func (s *Server) scaleResources(res http.ResponseWriter, req *http.Request, cluster *clusterState) {
+	var body struct {
+		Resource *hpdbv3.Resources `json:"resource"`
+	}
+	if !readJSON(res, req, &body) {
+		return
+	}
+	if body.Resource == nil {
+		writeError(res, http.StatusBadRequest, "invalid_request", "resource is required")
+		return
+	}
+	resource := *body.Resource
+	spec := map[string]interface{}{"resource": resource}
+	s.startAccepted(res, cluster, "scale_resources", spec, func(cluster *clusterState) {
+		if cluster.cluster.Resource == nil {
+			cluster.cluster.Resource = &hpdbv3.ClusterResource{}
+		}
+		if resource.Cpu != nil {
+			cluster.cluster.Resource.Cpu = resource.Cpu
+		}
+		if resource.Memory != nil {
+			cluster.cluster.Resource.Memory = resource.Memory
+		}
+		if resource.Storage != nil {
+			cluster.cluster.Resource.Storage = resource.Storage
+		}
+	})
+}
+
+func (s *Server) getConfiguration(res http.ResponseWriter, cluster *clusterState) {
+	if cluster.configuration == nil {
+		writeError(res, http.StatusBadRequest, "not_supported", "configuration is only supported by PostgreSQL clusters")
+		return
+	}
+	writeJSON(res, http.StatusOK, hpdbv3.Configuration{Configuration: cluster.configuration})
+}
+
+func (s *Server) updateConfiguration(res http.ResponseWriter, req *http.Request, cluster *clusterState) {
+	if cluster.configuration == nil {
+		writeError(res, http.StatusBadRequest, "not_supported", "configuration is only supported by PostgreSQL clusters")
+		return
+	}
+	var body struct {
+		Configuration map[string]hpdbv3.ParameterValue `json:"configuration"`
+	}
+	if !readJSON(res, req, &body) {
+		return
+	}
+	parameters := cluster.configuration.GetParameters()
+	for name, value := range body.Configuration {
+		parameter, ok := parameters[name]
+		if !ok {
+			writeError(res, http.StatusBadRequest, "invalid_parameter", "unknown configuration parameter: "+name)
+			return
+		}
+		if message := checkParameterValue(parameter, value); message != "" {
+			writeError(res, http.StatusBadRequest, "invalid_parameter", fmt.Sprintf("invalid value %s of %s: %s", value, name, message))
+			return
+		}
+	}
+	changes := body.Configuration
+	spec := map[string]interface{}{"configuration": changes}
+	s.startAccepted(res, cluster, "update_configuration", spec, func(cluster *clusterState) {
+		for name, value := range changes {
+			parameter := *cluster.configuration.GetParameter(name)
+			parameter.Value = value
+			cluster.configuration.SetParameter(name, &parameter)
+		}
+	})
+}
+
+// checkParameterValue returns why the value is not valid for the parameter, or "" if it is valid.
+func checkParameterValue(parameter *hpdbv3.Parameter, value hpdbv3.ParameterValue) string {
+	switch parameter.Type {
+	case hpdbv3.ParameterTypeInteger:
+		i, ok := value.Int64()
+		if !ok {
+			return "it is not an integer"
+		}
+		if (parameter.Min != nil && i < *parameter.Min) || (parameter.Max != nil && i > *parameter.Max) {
+			return "it is out of range"
+		}
+	case hpdbv3.ParameterTypeBool:
+		if _, ok := value.Bool(); !ok {
+			return "it is not a boolean"
+		}
+	case hpdbv3.ParameterTypeEnum:
+		for _, allowed := range parameter.AllowedValues {
+			if value.Kind() == hpdbv3.ParameterTypeString && strings.EqualFold(value.String(), allowed) {
+				return ""
+			}
+		}
+		return "allowed values are " + strings.Join(parameter.AllowedValues, ", ")
+	default:
+		if value.Kind() != hpdbv3.ParameterTypeString {
+			return "it is not a string"
+		}
+	}
+	return ""
+}
+
+func (s *Server) listTasks(res http.ResponseWriter, cluster *clusterState) {
+	tasks := hpdbv3.Tasks{Tasks: []hpdbv3.TaskItem{}}
+	for i := len(cluster.tasks) - 1; i >= 0; i-- {
+		task := cluster.tasks[i].task
+		tasks.Tasks = append(tasks.Tasks, hpdbv3.TaskItem{
+			ID:         task.ID,
+			Type:       task.Type,
+			State:      task.State,
+			Reason:     task.Reason,
+			StartedAt:  task.StartedAt,
+			FinishedAt: task.FinishedAt,
+		})
+	}
+	writeJSON(res, http.StatusOK, tasks)
+}
+
+func (s *Server) getTask(res http.ResponseWriter, cluster *clusterState, taskID string) {
+	for _, task := range cluster.tasks {
+		if *task.task.ID == taskID {
+			writeJSON(res, http.StatusOK, task.task)
+			return
+		}
+	}
+	writeError(res, http.StatusNotFound, "task_not_found", "task not found: "+taskID)
+}
+
+func (s *Server) enableCosBackup(res http.ResponseWriter, req *http.Request, cluster *clusterState) {
+	var body hpdbv3.CosBackupConfig
+	if !readJSON(res, req, &body) {
+		return
+	}
+	if body.CosEndpoint == nil || body.BucketInstanceCrn == nil || body.CosHmacKeys == nil {
+		writeError(res, http.StatusBadRequest, "invalid_request", "cos_hmac_keys, cos_endpoint and bucket_instance_crn are required")
+		return
+	}
+	if cluster.cluster.IsCosBackupEnabled != nil && *cluster.cluster.IsCosBackupEnabled {
+		writeError(res, http.StatusConflict, "backup_enabled", "backup to COS is already enabled")
+		return
+	}
+	schedule := body.Schedule
+	if schedule == nil {
+		schedule = hpdbv3.NewBackupSchedule(hpdbv3.DefaultBackupInterval)
+	}
+	config := &hpdbv3.GetBackupConfigResponseCos{
+		CosEndpoint:       body.CosEndpoint,
+		BucketInstanceCrn: body.BucketInstanceCrn,
+		Schedule:          schedule,
+	}
+	spec := map[string]interface{}{"cos_endpoint": body.CosEndpoint, "bucket_instance_crn": body.BucketInstanceCrn}
+	s.startAccepted(res, cluster, "enable_cos_backup", spec, func(cluster *clusterState) {
+		cluster.cluster.IsCosBackupEnabled = core.BoolPtr(true)
+		cluster.backupConfig = config
+	})
+}
+
+func (s *Server) disableCosBackup(res http.ResponseWriter, cluster *clusterState) {
+	if cluster.cluster.IsCosBackupEnabled == nil || !*cluster.cluster.IsCosBackupEnabled {
+		writeError(res, http.StatusConflict, "backup_disabled", "backup to COS is not enabled")
+		return
+	}
+	s.startAccepted(res, cluster, "disable_cos_backup", nil, func(cluster *clusterState) {
+		cluster.cluster.IsCosBackupEnabled = core.BoolPtr(false)
+		cluster.backupConfig = nil
+	})
+}
+
+func (s *Server) getCosBackupConfig(res http.ResponseWriter, cluster *clusterState) {
+	if cluster.backupConfig == nil {
+		writeError(res, http.StatusNotFound, "backup_disabled", "backup to COS is not enabled")
+		return
+	}
+	writeJSON(res, http.StatusOK, hpdbv3.GetCosBackupConfigResponse{
+		CosEndpoint:       cluster.backupConfig.CosEndpoint,
+		BucketInstanceCrn: cluster.backupConfig.BucketInstanceCrn,
+	})
+}
+
+func (s *Server) getBackupConfig(res http.ResponseWriter, cluster *clusterState) {
+	writeJSON(res, http.StatusOK, hpdbv3.GetBackupConfigResponse{Cos: cluster.backupConfig})
+}
+
+func (s *Server) updateBackupConfig(res http.ResponseWriter, req *http.Request, cluster *clusterState) {
+	var body hpdbv3.UpdateBackupConfigOptions
+	if !readJSON(res, req, &body) {
+		return
+	}
+	if cluster.backupConfig == nil {
+		writeError(res, http.StatusConflict, "backup_disabled", "backup to COS is not enabled")
+		return
+	}
+	if body.Cos == nil {
+		writeError(res, http.StatusBadRequest, "invalid_request", "cos is required")
+		return
+	}
+	cos := *body.Cos
+	spec := map[string]interface{}{"cos": cos}
+	s.startAccepted(res, cluster, "update_backup_config", spec, func(cluster *clusterState) {
+		config := *cluster.backupConfig
+		if cos.CosEndpoint != nil {
+			config.CosEndpoint = cos.CosEndpoint
+		}
+		if cos.BucketInstanceCrn != nil {
+			config.BucketInstanceCrn = cos.BucketInstanceCrn
+		}
+		if cos.Schedule != nil {
+			config.Schedule = cos.Schedule
+		}
+		cluster.backupConfig = &config
+	})
+}
+
+func (s *Server) restore(res http.ResponseWriter, req *http.Request, cluster *clusterState) {
+	var body hpdbv3.RestoreOptions
+	if !readJSON(res, req, &body) {
+		return
+	}
+	sourceType := core.StringNilMapper(body.SourceType)
+	switch sourceType {
+	case "", "default":
+		found := false
+		for _, backup := range cluster.backups {
+			found = found || (body.BackupID != nil && backup.ID != nil && *backup.ID == *body.BackupID)
+		}
+		if !found {
+			writeError(res, http.StatusNotFound, "backup_not_found", "backup not found: "+core.StringNilMapper(body.BackupID))
+			return
+		}
+	case "cos":
+		if body.BackupFile == nil || body.CosEndpoint == nil || body.BucketInstanceCrn == nil {
+			writeError(res, http.StatusBadRequest, "invalid_request", "backup_file, cos_endpoint and bucket_instance_crn are required")
+			return
+		}
+	default:
+		writeError(res, http.StatusBadRequest, "invalid_request", "invalid source_type: "+sourceType)
+		return
+	}
+	spec := map[string]interface{}{
+		"source_type": sourceType,
+		"backup_id":   body.BackupID,
+		"backup_file": body.BackupFile,
+	}
+	s.startAccepted(res, cluster, "restore", spec, nil)
+}
+
+func (s *Server) listNodeLogs(res http.ResponseWriter, nodeID string) {
+	logs := hpdbv3.LogList{Logs: []hpdbv3.Log{}}
+	for _, log := range s.sortedLogs(nodeID) {
+		logs.Logs = append(logs.Logs, log.log)
+	}
+	writeJSON(res, http.StatusOK, logs)
+}
+
+func (s *Server) getLog(res http.ResponseWriter, req *http.Request, nodeID string, logName string) {
+	for _, log := range s.logs[nodeID] {
+		if core.StringNilMapper(log.log.Filename) == logName {
+			contentType := "application/json"
+			if strings.Contains(req.Header.Get("Accept"), "application/x-download") {
+				contentType = "application/x-download"
+			}
+			res.Header().Set("Content-Type", contentType)
+			res.WriteHeader(http.StatusOK)
+			_, _ = res.Write(log.content)
+			return
+		}
+	}
+	writeError(res, http.StatusNotFound, "log_not_found", "log not found: "+logName)
+}
+
+// startAccepted starts a task on the cluster and writes its ID in a 202 response.
+func (s *Server) startAccepted(res http.ResponseWriter, cluster *clusterState, taskType string, spec map[string]interface{}, apply func(cluster *clusterState)) {
+	if cluster.cluster.GetState() == hpdbv3.ClusterStateFailed {
+		writeError(res, http.StatusConflict, "cluster_failed", "cluster is in the FAILED state: "+core.StringNilMapper(cluster.cluster.Reason))
+		return
+	}
+	task := s.startTask(cluster, taskType, spec, apply)
+	writeJSON(res, http.StatusAccepted, hpdbv3.TaskID{TaskID: task.task.ID})
+}
+
+// readJSON decodes the request body into v, writing a 400 response if it is not valid JSON.
+func readJSON(res http.ResponseWriter, req *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(req.Body).Decode(v); err != nil {
+		writeError(res, http.StatusBadRequest, "invalid_request", "invalid request body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// writeJSON writes v as a JSON response with the specified status code.
+func writeJSON(res http.ResponseWriter, statusCode int, v interface{}) {
+	res.Header().Set("Content-Type", "application/json")
+	res.WriteHeader(statusCode)
+	_ = json.NewEncoder(res).Encode(v)
+}
+
+// writeError writes an error response in the format returned by the service.
+func writeError(res http.ResponseWriter, statusCode int, code string, message string) {
+	writeJSON(res, statusCode, map[string]interface{}{
+		"errors": []map[string]string{{"code": code, "message": message}},
+	})
+}
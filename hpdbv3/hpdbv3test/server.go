@@ -0,0 +1,445 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package hpdbv3test provides an in-process fake of the HpdbV3 service for use in unit tests.
+//
+// A Server implements every route used by the hpdbv3 package on top of an httptest.Server. It is seeded with
+// clusters, users, databases, backups and logs, and the mutating operations create tasks which progress from RUNNING
+// to SUCCEEDED or FAILED as the simulated clock of the server advances:
+//
+//	server := hpdbv3test.NewServer()
+//	defer server.Close()
+//	server.AddCluster(hpdbv3test.NewCluster("my-cluster"))
+//
+//	hpdb, err := server.NewClient()
+//	...
+//	taskID, _, err := hpdb.ScaleResources(hpdb.NewScaleResourcesOptions("my-cluster").SetResource(resources))
+//	server.Advance(server.TaskDuration())
+//
+// Failures can be scripted as well: InjectFault makes requests fail with error status codes, respond slowly or return
+// truncated bodies, FailNextTask and FailNextTaskOnNode make tasks fail, and FailCluster moves a cluster into the
+// FAILED state.
+package hpdbv3test
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+)
+
+// DefaultTaskDuration is the simulated time a task stays in the RUNNING state.
+const DefaultTaskDuration = 30 * time.Second
+
+// timestampFormat is the format of the timestamps returned by the server.
+const timestampFormat = time.RFC3339
+
+// Server : An in-process fake of the HpdbV3 service.
+type Server struct {
+	*httptest.Server
+
+	mu             sync.Mutex
+	taskDuration   time.Duration
+	now            time.Time
+	requestAdvance time.Duration
+	clusters       map[string]*clusterState
+	logs           map[string][]*logState
+	nextTaskFails  *taskFailure
+	taskCount      int
+	faults         []*faultState
+	requests       []string
+}
+
+// clusterState holds the simulated state of a cluster.
+type clusterState struct {
+	cluster       hpdbv3.Cluster
+	users         []hpdbv3.UserDetails
+	databases     []hpdbv3.Database
+	configuration *hpdbv3.ConfigurationItem
+	backups       []hpdbv3.Backup
+	backupConfig  *hpdbv3.GetBackupConfigResponseCos
+	tasks         []*taskState
+}
+
+// taskState holds a task together with the simulated time it finishes and the change it applies on success.
+type taskState struct {
+	task       hpdbv3.Task
+	finishesAt time.Time
+	failure    *taskFailure
+	apply      func(cluster *clusterState)
+}
+
+// taskFailure describes why a task fails, and on which node. An empty node ID fails the task on every node.
+type taskFailure struct {
+	nodeID string
+	reason string
+}
+
+// logState holds a log file of a node.
+type logState struct {
+	log     hpdbv3.Log
+	content []byte
+}
+
+// NewServer starts and returns a new Server with no clusters. The caller should call Close when finished, to shut it
+// down.
+func NewServer() *Server {
+	s := &Server{
+		taskDuration: DefaultTaskDuration,
+		now:          time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		clusters:     make(map[string]*clusterState),
+		logs:         make(map[string][]*logState),
+	}
+	s.Server = httptest.NewServer(s.handler())
+	return s
+}
+
+// TaskDuration returns the simulated time a task stays in the RUNNING state.
+func (s *Server) TaskDuration() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.taskDuration
+}
+
+// SetTaskDuration sets the simulated time a task stays in the RUNNING state. It applies to the tasks created afterwards.
+// A duration of 0 makes tasks finish as soon as they are created.
+func (s *Server) SetTaskDuration(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.taskDuration = d
+}
+
+// NewClient returns an HpdbV3 client which sends its requests to the server.
+func (s *Server) NewClient() (*hpdbv3.HpdbV3, error) {
+	return hpdbv3.NewHpdbV3(&hpdbv3.HpdbV3Options{
+		URL:           s.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+}
+
+// Now returns the current simulated time of the server.
+func (s *Server) Now() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.now
+}
+
+// Advance moves the simulated clock of the server forward by d, finishing every task whose duration has elapsed.
+func (s *Server) Advance(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.advance(d)
+}
+
+// AdvanceOnRequest makes every request received by the server move the simulated clock forward by d before it is
+// handled. This lets clients which poll tasks in real time, such as HpdbV3.WaitForTask, observe tasks finishing.
+func (s *Server) AdvanceOnRequest(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requestAdvance = d
+}
+
+// advance moves the simulated clock forward and finishes the tasks that are due. The caller must hold s.mu.
+func (s *Server) advance(d time.Duration) {
+	s.now = s.now.Add(d)
+	for _, cluster := range s.clusters {
+		for _, task := range cluster.tasks {
+			if task.task.GetState() == hpdbv3.TaskStateRunning && !s.now.Before(task.finishesAt) {
+				s.finishTask(cluster, task)
+			}
+		}
+	}
+}
+
+// finishTask moves a task into its final state. The caller must hold s.mu.
+func (s *Server) finishTask(cluster *clusterState, task *taskState) {
+	state := "SUCCEEDED"
+	if task.failure != nil {
+		state = "FAILED"
+		task.task.Reason = core.StringPtr(task.failure.reason)
+	} else if task.apply != nil {
+		task.apply(cluster)
+	}
+	finishedAt := task.finishesAt.Format(timestampFormat)
+	task.task.State = core.StringPtr(state)
+	task.task.FinishedAt = core.StringPtr(finishedAt)
+	for i := range task.task.Nodes {
+		node := &task.task.Nodes[i]
+		node.State = core.StringPtr("SUCCEEDED")
+		node.FinishedAt = core.StringPtr(finishedAt)
+		if task.failure != nil && (task.failure.nodeID == "" || task.failure.nodeID == *node.ID) {
+			node.State = core.StringPtr("FAILED")
+			node.Reason = core.StringPtr(task.failure.reason)
+		}
+	}
+	cluster.cluster.UpdatedAt = core.StringPtr(finishedAt)
+}
+
+// FailNextTask makes the next task created by the server end in the FAILED state with the specified reason.
+func (s *Server) FailNextTask(reason string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextTaskFails = &taskFailure{reason: reason}
+}
+
+// NewCluster returns a running PostgreSQL cluster with three nodes which can be passed to AddCluster.
+func NewCluster(clusterID string) hpdbv3.Cluster {
+	var nodes []hpdbv3.Node
+	for i, replicaState := range []string{"PRIMARY", "SECONDARY", "SECONDARY"} {
+		nodes = append(nodes, hpdbv3.Node{
+			ID:            core.StringPtr(fmt.Sprintf("%s-node-%d", clusterID, i+1)),
+			ReplicaState:  core.StringPtr(replicaState),
+			NodeState:     core.StringPtr("RUNNING"),
+			StoppedReason: core.StringPtr(""),
+			Name:          core.StringPtr(fmt.Sprintf("%s-%d", clusterID, 30000+i)),
+		})
+	}
+	return hpdbv3.Cluster{
+		ID:                 core.StringPtr(clusterID),
+		Crn:                core.StringPtr(fmt.Sprintf("crn:v1:bluemix:public:hyperp-dbaas-postgresql:us-south:a/23a24a3e3fe7a115473f07be1c44bdb5:%s::", clusterID)),
+		IsCosBackupEnabled: core.BoolPtr(false),
+		Region:             core.StringPtr("us-south"),
+		Name:               core.StringPtr(clusterID),
+		State:              core.StringPtr("RUNNING"),
+		DbType:             core.StringPtr("postgresql"),
+		DbVersion:          core.StringPtr("12"),
+		PlanID:             core.StringPtr("postgresql-flexible"),
+		ReplicaCount:       core.Int64Ptr(int64(3)),
+		UserID:             core.StringPtr("23a24a3e3fe7a115473f07be1c44bdb5"),
+		Resource: &hpdbv3.ClusterResource{
+			Cpu:         core.Int64Ptr(int64(2)),
+			Memory:      core.StringPtr("4GiB"),
+			Storage:     core.StringPtr("10GiB"),
+			StorageUsed: core.StringPtr("1GiB"),
+		},
+		Nodes: nodes,
+	}
+}
+
+// NewConfiguration returns the default PostgreSQL configuration which is used for clusters added without one.
+func NewConfiguration() hpdbv3.ConfigurationItem {
+	parameter := func(description string, value int64, min int64, max int64, requiresRestart bool) *hpdbv3.IntegerType {
+		return &hpdbv3.IntegerType{
+			Default:         core.Int64Ptr(value),
+			Description:     core.StringPtr(description),
+			Max:             core.Int64Ptr(max),
+			Min:             core.Int64Ptr(min),
+			RequiresRestart: core.BoolPtr(requiresRestart),
+			Type:            core.StringPtr("integer"),
+			Value:           core.Int64Ptr(value),
+		}
+	}
+	configuration := hpdbv3.ConfigurationItem{
+		DeadlockTimeout:         parameter("Sets the time to wait on a lock before checking for deadlock, in milliseconds.", 1000, 1, 2147483647, false),
+		MaxLocksPerTransaction:  parameter("Sets the maximum number of locks per transaction.", 64, 10, 2147483647, true),
+		SharedBuffers:           parameter("Sets the number of shared memory buffers used by the server, in 8kB pages.", 16384, 16, 1073741823, true),
+		MaxConnections:          parameter("Sets the maximum number of concurrent connections.", 115, 1, 262143, true),
+		MaxPreparedTransactions: parameter("Sets the maximum number of simultaneously prepared transactions.", 0, 0, 262143, true),
+	}
+	configuration.SetParameter("work_mem", &hpdbv3.Parameter{
+		Type:            hpdbv3.ParameterTypeInteger,
+		Value:           hpdbv3.IntegerValue(4096),
+		Default:         hpdbv3.IntegerValue(4096),
+		Min:             core.Int64Ptr(64),
+		Max:             core.Int64Ptr(2147483647),
+		Description:     core.StringPtr("Sets the maximum memory to be used for query workspaces, in kB."),
+		RequiresRestart: core.BoolPtr(false),
+	})
+	configuration.SetParameter("log_connections", &hpdbv3.Parameter{
+		Type:            hpdbv3.ParameterTypeBool,
+		Value:           hpdbv3.BoolValue(false),
+		Default:         hpdbv3.BoolValue(false),
+		Description:     core.StringPtr("Logs each successful connection."),
+		RequiresRestart: core.BoolPtr(false),
+	})
+	configuration.SetParameter("log_min_messages", &hpdbv3.Parameter{
+		Type:            hpdbv3.ParameterTypeEnum,
+		Value:           hpdbv3.StringValue("warning"),
+		Default:         hpdbv3.StringValue("warning"),
+		AllowedValues:   []string{"debug5", "debug4", "debug3", "debug2", "debug1", "info", "notice", "warning", "error", "log", "fatal", "panic"},
+		Description:     core.StringPtr("Sets the message levels that are logged."),
+		RequiresRestart: core.BoolPtr(false),
+	})
+	return configuration
+}
+
+// AddCluster adds a cluster to the server, replacing any cluster with the same ID. Timestamps which are not set are
+// filled in from the simulated clock, and PostgreSQL clusters get the configuration returned by NewConfiguration.
+func (s *Server) AddCluster(cluster hpdbv3.Cluster) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := core.StringPtr(s.now.Format(timestampFormat))
+	if cluster.CreatedAt == nil {
+		cluster.CreatedAt = now
+	}
+	if cluster.UpdatedAt == nil {
+		cluster.UpdatedAt = now
+	}
+	cluster.Nodes = append([]hpdbv3.Node(nil), cluster.Nodes...)
+	for i := range cluster.Nodes {
+		if cluster.Nodes[i].CreatedAt == nil {
+			cluster.Nodes[i].CreatedAt = now
+		}
+		if cluster.Nodes[i].UpdatedAt == nil {
+			cluster.Nodes[i].UpdatedAt = now
+		}
+	}
+	state := &clusterState{cluster: cluster}
+	if cluster.DbType == nil || *cluster.DbType == "postgresql" {
+		configuration := NewConfiguration()
+		state.configuration = &configuration
+	}
+	s.clusters[*cluster.ID] = state
+}
+
+// Cluster returns the current state of the cluster that is indicated by its ID.
+func (s *Server) Cluster(clusterID string) (cluster hpdbv3.Cluster, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state, ok := s.clusters[clusterID]
+	if !ok {
+		return
+	}
+	return state.cluster, true
+}
+
+// UpdateCluster calls update with the cluster that is indicated by its ID so that tests can change its state, for
+// example its storage usage. It panics if the cluster does not exist.
+func (s *Server) UpdateCluster(clusterID string, update func(cluster *hpdbv3.Cluster)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	update(&s.mustCluster(clusterID).cluster)
+}
+
+// AddUser adds a database user to the cluster that is indicated by its ID.
+func (s *Server) AddUser(clusterID string, user hpdbv3.UserDetails) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state := s.mustCluster(clusterID)
+	state.users = append(state.users, user)
+}
+
+// AddDatabase adds a database to the cluster that is indicated by its ID.
+func (s *Server) AddDatabase(clusterID string, database hpdbv3.Database) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state := s.mustCluster(clusterID)
+	state.databases = append(state.databases, database)
+}
+
+// SetConfiguration replaces the database configuration of the cluster that is indicated by its ID.
+func (s *Server) SetConfiguration(clusterID string, configuration hpdbv3.ConfigurationItem) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// Copy the parameters, so that the updates of the configuration do not change the map of the caller
+	configuration.Parameters = configuration.GetParameters()
+	s.mustCluster(clusterID).configuration = &configuration
+}
+
+// AddBackup adds a backup to the cluster that is indicated by its ID. If the creation time is not set, the simulated
+// clock is used.
+func (s *Server) AddBackup(clusterID string, backup hpdbv3.Backup) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state := s.mustCluster(clusterID)
+	if backup.CreatedAt == nil {
+		backup.CreatedAt = core.StringPtr(s.now.Format(timestampFormat))
+	}
+	state.backups = append(state.backups, backup)
+}
+
+// AddLog adds a log file with the specified content to the node that is indicated by its ID. The size of the log is
+// taken from the content if it is not set.
+func (s *Server) AddLog(nodeID string, log hpdbv3.Log, content []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if log.Size == nil {
+		log.Size = core.Int64Ptr(int64(len(content)))
+	}
+	if log.LastModified == nil {
+		log.LastModified = core.StringPtr(s.now.Format(timestampFormat))
+	}
+	s.logs[nodeID] = append(s.logs[nodeID], &logState{log: log, content: content})
+}
+
+// Tasks returns the tasks of the cluster that is indicated by its ID, newest first.
+func (s *Server) Tasks(clusterID string) []hpdbv3.Task {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state, ok := s.clusters[clusterID]
+	if !ok {
+		return nil
+	}
+	var tasks []hpdbv3.Task
+	for i := len(state.tasks) - 1; i >= 0; i-- {
+		tasks = append(tasks, state.tasks[i].task)
+	}
+	return tasks
+}
+
+// mustCluster returns the state of a cluster, or panics if it does not exist. The caller must hold s.mu.
+func (s *Server) mustCluster(clusterID string) *clusterState {
+	state, ok := s.clusters[clusterID]
+	if !ok {
+		panic(fmt.Sprintf("hpdbv3test: cluster %s does not exist", clusterID))
+	}
+	return state
+}
+
+// startTask creates a RUNNING task on the cluster which runs apply when it succeeds. The caller must hold s.mu.
+func (s *Server) startTask(cluster *clusterState, taskType string, spec map[string]interface{}, apply func(cluster *clusterState)) *taskState {
+	s.taskCount++
+	startedAt := s.now.Format(timestampFormat)
+	task := &taskState{
+		task: hpdbv3.Task{
+			ID:        core.StringPtr(fmt.Sprintf("task-%d", s.taskCount)),
+			Type:      core.StringPtr(taskType),
+			StartedAt: core.StringPtr(startedAt),
+			State:     core.StringPtr("RUNNING"),
+			Spec:      spec,
+		},
+		finishesAt: s.now.Add(s.taskDuration),
+		failure:    s.nextTaskFails,
+		apply:      apply,
+	}
+	s.nextTaskFails = nil
+	for _, node := range cluster.cluster.Nodes {
+		task.task.Nodes = append(task.task.Nodes, hpdbv3.TaskNode{
+			ID:        node.ID,
+			State:     core.StringPtr("RUNNING"),
+			StartedAt: core.StringPtr(startedAt),
+		})
+	}
+	cluster.tasks = append(cluster.tasks, task)
+	if s.taskDuration <= 0 {
+		s.finishTask(cluster, task)
+	}
+	return task
+}
+
+// sortedLogs returns the logs of a node ordered by file name. The caller must hold s.mu.
+func (s *Server) sortedLogs(nodeID string) []*logState {
+	logs := append([]*logState(nil), s.logs[nodeID]...)
+	sort.SliceStable(logs, func(i, j int) bool {
+		return core.StringNilMapper(logs[i].log.Filename) < core.StringNilMapper(logs[j].log.Filename)
+	})
+	return logs
+}
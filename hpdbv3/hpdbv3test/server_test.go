@@ -0,0 +1,289 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hpdbv3test_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3"
+	"github.com/IBM/hpdb-go-sdk/hpdbv3/hpdbv3test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const clusterID = "9cebab98-afeb-4886-9a29-8e741716e7ff"
+
+func newServer(t *testing.T) (*hpdbv3test.Server, *hpdbv3.HpdbV3) {
+	server := hpdbv3test.NewServer()
+	t.Cleanup(server.Close)
+	server.AddCluster(hpdbv3test.NewCluster(clusterID))
+
+	hpdb, err := server.NewClient()
+	require.Nil(t, err)
+	return server, hpdb
+}
+
+func TestGetCluster(t *testing.T) {
+	_, hpdb := newServer(t)
+
+	cluster, response, err := hpdb.GetCluster(hpdb.NewGetClusterOptions(clusterID))
+	require.Nil(t, err)
+	assert.Equal(t, 200, response.StatusCode)
+	assert.Equal(t, clusterID, *cluster.ID)
+	assert.Equal(t, "RUNNING", *cluster.State)
+	assert.Len(t, cluster.Nodes, 3)
+	assert.Equal(t, "4GiB", *cluster.Resource.Memory)
+	assert.NotNil(t, cluster.CreatedAt)
+
+	_, response, err = hpdb.GetCluster(hpdb.NewGetClusterOptions("unknown"))
+	require.NotNil(t, err)
+	assert.Equal(t, 404, response.StatusCode)
+	assert.Contains(t, err.Error(), "cluster not found")
+}
+
+func TestServiceURLWithAccountID(t *testing.T) {
+	server, _ := newServer(t)
+
+	hpdb, err := hpdbv3.NewHpdbV3(&hpdbv3.HpdbV3Options{
+		URL:           server.URL + "/api/v3/23a24a3e3fe7a115473f07be1c44bdb5",
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	cluster, _, err := hpdb.GetCluster(hpdb.NewGetClusterOptions(clusterID))
+	require.Nil(t, err)
+	assert.Equal(t, clusterID, *cluster.ID)
+}
+
+func TestUsersAndDatabases(t *testing.T) {
+	server, hpdb := newServer(t)
+	server.AddUser(clusterID, hpdbv3.UserDetails{
+		Name:           core.StringPtr("admin"),
+		RoleAttributes: []string{"CREATEDB"},
+		DbAccess:       []hpdbv3.Access{{Db: core.StringPtr("postgres"), Privileges: []string{"CONNECT"}}},
+	})
+	server.AddDatabase(clusterID, hpdbv3.Database{Name: core.StringPtr("postgres"), SizeOnDisk: core.Int64Ptr(int64(100))})
+	server.AddDatabase(clusterID, hpdbv3.Database{Name: core.StringPtr("app"), SizeOnDisk: core.Int64Ptr(int64(200))})
+
+	users, _, err := hpdb.ListUsers(hpdb.NewListUsersOptions(clusterID))
+	require.Nil(t, err)
+	require.Len(t, users.Users, 1)
+	assert.Equal(t, "admin", *users.Users[0].Name)
+
+	user, _, err := hpdb.GetUser(hpdb.NewGetUserOptions(clusterID, "admin"))
+	require.Nil(t, err)
+	assert.Equal(t, "postgres", *user.DbAccess[0].Db)
+
+	_, response, err := hpdb.GetUser(hpdb.NewGetUserOptions(clusterID, "nobody"))
+	require.NotNil(t, err)
+	assert.Equal(t, 404, response.StatusCode)
+
+	databases, _, err := hpdb.ListDatabases(hpdb.NewListDatabasesOptions(clusterID))
+	require.Nil(t, err)
+	assert.Len(t, databases.Databases, 2)
+	assert.Equal(t, int64(300), *databases.TotalSize)
+}
+
+func TestScaleResourcesProgressesOverSimulatedTime(t *testing.T) {
+	server, hpdb := newServer(t)
+
+	scaleResourcesOptions := hpdb.NewScaleResourcesOptions(clusterID).SetResource(&hpdbv3.Resources{
+		Cpu:    core.Int64Ptr(int64(4)),
+		Memory: core.StringPtr("8GiB"),
+	})
+	taskID, response, err := hpdb.ScaleResources(scaleResourcesOptions)
+	require.Nil(t, err)
+	assert.Equal(t, 202, response.StatusCode)
+
+	task, _, err := hpdb.GetTask(hpdb.NewGetTaskOptions(clusterID, *taskID.TaskID))
+	require.Nil(t, err)
+	assert.Equal(t, "RUNNING", *task.State)
+	assert.Equal(t, "scale_resources", *task.Type)
+	assert.Len(t, task.Nodes, 3)
+
+	server.Advance(server.TaskDuration() / 2)
+	cluster, _ := server.Cluster(clusterID)
+	assert.Equal(t, "4GiB", *cluster.Resource.Memory)
+
+	server.Advance(server.TaskDuration() / 2)
+	task, _, err = hpdb.GetTask(hpdb.NewGetTaskOptions(clusterID, *taskID.TaskID))
+	require.Nil(t, err)
+	assert.Equal(t, "SUCCEEDED", *task.State)
+	assert.NotNil(t, task.FinishedAt)
+	assert.Equal(t, "SUCCEEDED", *task.Nodes[0].State)
+
+	updated, _, err := hpdb.GetCluster(hpdb.NewGetClusterOptions(clusterID))
+	require.Nil(t, err)
+	assert.Equal(t, int64(4), *updated.Resource.Cpu)
+	assert.Equal(t, "8GiB", *updated.Resource.Memory)
+	assert.Equal(t, "10GiB", *updated.Resource.Storage)
+
+	tasks, _, err := hpdb.ListTasks(hpdb.NewListTasksOptions(clusterID))
+	require.Nil(t, err)
+	require.Len(t, tasks.Tasks, 1)
+	assert.Equal(t, *taskID.TaskID, *tasks.Tasks[0].ID)
+}
+
+func TestFailNextTask(t *testing.T) {
+	server, hpdb := newServer(t)
+	server.AdvanceOnRequest(10 * time.Second)
+	server.FailNextTask("out of capacity")
+
+	waitForTaskOptions := hpdb.NewWaitForTaskOptions().SetInterval(time.Millisecond)
+	scaleResourcesOptions := hpdb.NewScaleResourcesOptions(clusterID).SetResource(&hpdbv3.Resources{Cpu: core.Int64Ptr(int64(4))})
+	_, _, err := hpdb.ScaleResourcesAndWait(context.Background(), scaleResourcesOptions, waitForTaskOptions)
+
+	var taskFailedErr *hpdbv3.TaskFailedError
+	require.True(t, errors.As(err, &taskFailedErr))
+	assert.Equal(t, "out of capacity", taskFailedErr.Reason)
+	assert.Len(t, taskFailedErr.Nodes, 3)
+
+	cluster, _ := server.Cluster(clusterID)
+	assert.Equal(t, int64(2), *cluster.Resource.Cpu)
+
+	// Only the next task fails
+	_, _, err = hpdb.ScaleResourcesAndWait(context.Background(), scaleResourcesOptions, waitForTaskOptions)
+	require.Nil(t, err)
+	cluster, _ = server.Cluster(clusterID)
+	assert.Equal(t, int64(4), *cluster.Resource.Cpu)
+}
+
+func TestConfiguration(t *testing.T) {
+	server, hpdb := newServer(t)
+	server.SetTaskDuration(0)
+
+	configuration, _, err := hpdb.GetConfiguration(hpdb.NewGetConfigurationOptions(clusterID))
+	require.Nil(t, err)
+	assert.Equal(t, int64(115), *configuration.Configuration.MaxConnections.Value)
+
+	updateConfigurationOptions := hpdb.NewUpdateConfigurationOptions(clusterID).SetConfiguration(&hpdbv3.Configurations{
+		MaxConnections: core.Int64Ptr(int64(200)),
+	})
+	_, _, err = hpdb.UpdateConfiguration(updateConfigurationOptions)
+	require.Nil(t, err)
+
+	configuration, _, err = hpdb.GetConfiguration(hpdb.NewGetConfigurationOptions(clusterID))
+	require.Nil(t, err)
+	assert.Equal(t, int64(200), *configuration.Configuration.MaxConnections.Value)
+
+	updateConfigurationOptions.SetConfiguration(&hpdbv3.Configurations{MaxConnections: core.Int64Ptr(int64(0))})
+	_, response, err := hpdb.UpdateConfiguration(updateConfigurationOptions)
+	require.NotNil(t, err)
+	assert.Equal(t, 400, response.StatusCode)
+}
+
+func TestBackupsAndRestore(t *testing.T) {
+	server, hpdb := newServer(t)
+	server.SetTaskDuration(0)
+	server.AddBackup(clusterID, hpdbv3.Backup{ID: core.StringPtr("backup-1"), Type: core.StringPtr("scheduled")})
+
+	backups, _, err := hpdb.ListBackups(hpdb.NewListBackupsOptions(clusterID))
+	require.Nil(t, err)
+	require.Len(t, backups.Backups, 1)
+	assert.Equal(t, "backup-1", *backups.Backups[0].ID)
+
+	enableCosBackupOptions := hpdb.NewEnableCosBackupOptions(clusterID).
+		SetCosEndpoint("s3.us-south.cloud-object-storage.appdomain.cloud").
+		SetBucketInstanceCrn("crn:v1:bluemix:public:cloud-object-storage:global:a/abc:def:bucket:backups").
+		SetCosHmacKeys(&hpdbv3.CosHmacKeys{AccessKeyID: core.StringPtr("id"), SecretAccessKey: core.StringPtr("secret")})
+	_, _, err = hpdb.EnableCosBackup(enableCosBackupOptions)
+	require.Nil(t, err)
+
+	cluster, _, err := hpdb.GetCluster(hpdb.NewGetClusterOptions(clusterID))
+	require.Nil(t, err)
+	assert.True(t, *cluster.IsCosBackupEnabled)
+
+	backupConfig, _, err := hpdb.GetBackupConfig(hpdb.NewGetBackupConfigOptions(clusterID))
+	require.Nil(t, err)
+	assert.Equal(t, "8h", *backupConfig.Cos.Schedule.Value)
+
+	updateBackupConfigOptions := hpdb.NewUpdateBackupConfigOptions(clusterID).SetCos(&hpdbv3.CosBackupConfig{
+		Schedule: &hpdbv3.BackupSchedule{Type: core.StringPtr("frequency"), Value: core.StringPtr("1d")},
+	})
+	_, _, err = hpdb.UpdateBackupConfig(updateBackupConfigOptions)
+	require.Nil(t, err)
+
+	cosBackupConfig, _, err := hpdb.GetCosBackupConfig(hpdb.NewGetCosBackupConfigOptions(clusterID))
+	require.Nil(t, err)
+	assert.Equal(t, "s3.us-south.cloud-object-storage.appdomain.cloud", *cosBackupConfig.CosEndpoint)
+
+	backupConfig, _, err = hpdb.GetBackupConfig(hpdb.NewGetBackupConfigOptions(clusterID))
+	require.Nil(t, err)
+	assert.Equal(t, "1d", *backupConfig.Cos.Schedule.Value)
+
+	restoreOptions := hpdb.NewRestoreOptions(clusterID).SetSourceType("default").SetBackupID("backup-1")
+	taskID, _, err := hpdb.Restore(restoreOptions)
+	require.Nil(t, err)
+	task, _, err := hpdb.GetTask(hpdb.NewGetTaskOptions(clusterID, *taskID.TaskID))
+	require.Nil(t, err)
+	assert.Equal(t, "restore", *task.Type)
+	assert.Equal(t, "SUCCEEDED", *task.State)
+
+	_, response, err := hpdb.Restore(restoreOptions.SetBackupID("backup-2"))
+	require.NotNil(t, err)
+	assert.Equal(t, 404, response.StatusCode)
+
+	_, _, err = hpdb.DisableCosBackup(hpdb.NewDisableCosBackupOptions(clusterID))
+	require.Nil(t, err)
+	state, _ := server.Cluster(clusterID)
+	assert.False(t, *state.IsCosBackupEnabled)
+}
+
+func TestLogs(t *testing.T) {
+	server, hpdb := newServer(t)
+	nodeID := clusterID + "-node-1"
+	server.AddLog(nodeID, hpdbv3.Log{Filename: core.StringPtr("postgresql.log")}, []byte("LOG: database system is ready"))
+	server.AddLog(nodeID, hpdbv3.Log{Filename: core.StringPtr("audit.log")}, []byte("audit"))
+
+	logList, _, err := hpdb.ListNodeLogs(hpdb.NewListNodeLogsOptions(nodeID))
+	require.Nil(t, err)
+	require.Len(t, logList.Logs, 2)
+	assert.Equal(t, "audit.log", *logList.Logs[0].Filename)
+	assert.Equal(t, int64(5), *logList.Logs[0].Size)
+
+	file, _, err := hpdb.GetLog(hpdb.NewGetLogOptions(nodeID, "postgresql.log").SetAccept("application/x-download"))
+	require.Nil(t, err)
+	defer file.Close()
+	content, err := io.ReadAll(file)
+	require.Nil(t, err)
+	assert.Equal(t, "LOG: database system is ready", string(content))
+
+	_, response, err := hpdb.GetLog(hpdb.NewGetLogOptions(nodeID, "missing.log"))
+	require.NotNil(t, err)
+	assert.Equal(t, 404, response.StatusCode)
+}
+
+func TestLogsWithoutFilename(t *testing.T) {
+	server, hpdb := newServer(t)
+	nodeID := clusterID + "-node-1"
+	server.AddLog(nodeID, hpdbv3.Log{Filename: core.StringPtr("postgresql.log")}, []byte("LOG: database system is ready"))
+	server.AddLog(nodeID, hpdbv3.Log{}, []byte("partial"))
+
+	logList, _, err := hpdb.ListNodeLogs(hpdb.NewListNodeLogsOptions(nodeID))
+	require.Nil(t, err)
+	require.Len(t, logList.Logs, 2)
+	assert.Nil(t, logList.Logs[0].Filename)
+	assert.Equal(t, "postgresql.log", *logList.Logs[1].Filename)
+
+	_, response, err := hpdb.GetLog(hpdb.NewGetLogOptions(nodeID, "missing.log"))
+	require.NotNil(t, err)
+	assert.Equal(t, 404, response.StatusCode)
+}